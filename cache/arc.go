@@ -0,0 +1,199 @@
+package cache
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+// arcItem remembers which of the four ARC lists holds the entry.
+type arcItem[K, V any] struct {
+	elem *entry[K, V]
+	list *entryList[K, V]
+}
+
+// arcCache follows the Adaptive Replacement Cache of Megiddo and Modha. recent (T1) and frequent (T2) hold the
+// cached entries seen once and at least twice. recentGhosts (B1) and frequentGhosts (B2) remember the keys
+// recently evicted from each of them, and a hit on a ghost moves target, the desired size of recent, towards
+// the list that would have kept it.
+type arcCache[K, V any] struct {
+	index          TDADictionary.Dictionary[K, *arcItem[K, V]]
+	recent         *entryList[K, V]
+	frequent       *entryList[K, V]
+	recentGhosts   *entryList[K, V]
+	frequentGhosts *entryList[K, V]
+	target         int
+	capacity       int
+	cmp            func(K, K) bool
+	onEvict        func(K, V)
+	stats          Stats
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE CACHE ------------ //
+
+// CreateARC creates a cache with the Adaptive Replacement Cache policy, which balances recency and frequency
+// on its own and resists scans that would flush an LRU cache. Besides the cached entries, it remembers up to
+// capacity evicted keys. Keys are compared with cmp, as in dictionary.CreateHash, and onEvict, if not nil, is
+// called with every evicted entry.
+// If the capacity is not positive, it panics with "The capacity must be positive".
+func CreateARC[K, V any](capacity int, cmp func(K, K) bool, onEvict func(key K, value V)) Cache[K, V] {
+	if capacity <= 0 {
+		panic(_PANIC_CAPACITY_MSG)
+	}
+	return &arcCache[K, V]{
+		index:          TDADictionary.CreateHash[K, *arcItem[K, V]](cmp),
+		recent:         newEntryList[K, V](),
+		frequent:       newEntryList[K, V](),
+		recentGhosts:   newEntryList[K, V](),
+		frequentGhosts: newEntryList[K, V](),
+		capacity:       capacity,
+		cmp:            cmp,
+		onEvict:        onEvict,
+	}
+}
+
+// ------------ CACHE PRIMITIVES ------------ //
+
+func (cache *arcCache[K, V]) Get(key K) (V, bool) {
+	item, cached := cache.lookup(key)
+	if !cached {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	cache.moveTo(item, cache.frequent)
+	cache.stats.Hits++
+	return item.elem.value, true
+}
+
+func (cache *arcCache[K, V]) Put(key K, value V) {
+	item, cached := cache.lookup(key)
+	switch {
+	case cached:
+		item.elem.value = value
+		cache.moveTo(item, cache.frequent)
+
+	case item != nil && item.list == cache.recentGhosts:
+		cache.target = min(cache.capacity, cache.target+max(cache.frequentGhosts.length/cache.recentGhosts.length, 1))
+		cache.replace(false)
+		item.elem.value = value
+		cache.moveTo(item, cache.frequent)
+
+	case item != nil:
+		cache.target = max(0, cache.target-max(cache.recentGhosts.length/cache.frequentGhosts.length, 1))
+		cache.replace(true)
+		item.elem.value = value
+		cache.moveTo(item, cache.frequent)
+
+	default:
+		cache.makeRoomForNewKey()
+		item = &arcItem[K, V]{elem: &entry[K, V]{key: key, value: value}, list: cache.recent}
+		cache.recent.pushFront(item.elem)
+		cache.index.Save(key, item)
+	}
+}
+
+func (cache *arcCache[K, V]) Peek(key K) (V, bool) {
+	item, cached := cache.lookup(key)
+	if !cached {
+		var zero V
+		return zero, false
+	}
+	return item.elem.value, true
+}
+
+func (cache *arcCache[K, V]) Remove(key K) bool {
+	if _, cached := cache.lookup(key); !cached {
+		return false
+	}
+	item := cache.index.Delete(key)
+	item.list.remove(item.elem)
+	return true
+}
+
+func (cache *arcCache[K, V]) Len() int {
+	return cache.recent.length + cache.frequent.length
+}
+
+func (cache *arcCache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+func (cache *arcCache[K, V]) Stats() Stats {
+	return cache.stats
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// lookup returns the item of the key, if any, and whether it is cached rather than a ghost.
+func (cache *arcCache[K, V]) lookup(key K) (*arcItem[K, V], bool) {
+	if !cache.index.Belongs(key) {
+		return nil, false
+	}
+	item := cache.index.Get(key)
+	return item, item.list == cache.recent || item.list == cache.frequent
+}
+
+// makeRoomForNewKey frees a slot for a key found in no list, keeping the ghost lists bounded.
+func (cache *arcCache[K, V]) makeRoomForNewKey() {
+	recentTotal := cache.recent.length + cache.recentGhosts.length
+	total := recentTotal + cache.frequent.length + cache.frequentGhosts.length
+
+	switch {
+	case recentTotal == cache.capacity:
+		if cache.recent.length < cache.capacity {
+			cache.forget(cache.recentGhosts)
+			cache.replace(false)
+		} else {
+			victim := cache.recent.back()
+			cache.recent.remove(victim)
+			cache.index.Delete(victim.key)
+			cache.evicted(victim)
+		}
+	case total >= cache.capacity:
+		if total == 2*cache.capacity {
+			cache.forget(cache.frequentGhosts)
+		}
+		cache.replace(false)
+	}
+}
+
+// replace evicts the least recently used entry of recent or frequent, depending on how recent compares to
+// target, and turns it into a ghost. hitOnFrequentGhost breaks the tie when recent has exactly target entries.
+func (cache *arcCache[K, V]) replace(hitOnFrequentGhost bool) {
+	if cache.Len() < cache.capacity {
+		return
+	}
+
+	source, ghosts := cache.frequent, cache.frequentGhosts
+	if cache.frequent.isEmpty() || (cache.recent.length > 0 && (cache.recent.length > cache.target ||
+		(hitOnFrequentGhost && cache.recent.length == cache.target))) {
+		source, ghosts = cache.recent, cache.recentGhosts
+	}
+
+	victim := source.back()
+	cache.evicted(victim)
+
+	var zero V
+	victim.value = zero
+	cache.moveTo(cache.index.Get(victim.key), ghosts)
+}
+
+// forget drops the oldest key of a ghost list.
+func (cache *arcCache[K, V]) forget(ghosts *entryList[K, V]) {
+	oldest := ghosts.back()
+	ghosts.remove(oldest)
+	cache.index.Delete(oldest.key)
+}
+
+func (cache *arcCache[K, V]) evicted(victim *entry[K, V]) {
+	cache.stats.Evictions++
+	if cache.onEvict != nil {
+		cache.onEvict(victim.key, victim.value)
+	}
+}
+
+func (cache *arcCache[K, V]) moveTo(item *arcItem[K, V], list *entryList[K, V]) {
+	item.list.remove(item.elem)
+	list.pushFront(item.elem)
+	item.list = list
+}
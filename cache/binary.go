@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "cache"
+
+// The caches write binary snapshots of their entries, encoding keys and values with encoding/gob, in the reverse
+// order of their JSON encoding, so that any cache can read them back by putting them in order.
+// Reading a snapshot puts its entries into an empty cache with the same capacity, cmp and eviction callback, in
+// which they start over as used once, and keeps the statistics. It reads nothing past the end of the snapshot, and
+// if the snapshot cannot be read or holds more entries than the capacity, it returns an error without changing
+// the cache.
+
+// ------------ BINARY ENCODING ------------ //
+
+func (cache *lruCache[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return writeEntries(w, cache.recency.entries())
+}
+
+func (cache *lruCache[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateLRU[K, V](cache.capacity, cache.cmp, nil).(*lruCache[K, V])
+	return readEntries(r, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+func (cache *lfuCache[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return writeEntries(w, cache.entries())
+}
+
+func (cache *lfuCache[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateLFU[K, V](cache.capacity, cache.cmp, nil).(*lfuCache[K, V])
+	return readEntries(r, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+func (cache *arcCache[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return writeEntries(w, append(cache.recent.entries(), cache.frequent.entries()...))
+}
+
+func (cache *arcCache[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateARC[K, V](cache.capacity, cache.cmp, nil).(*arcCache[K, V])
+	return readEntries(r, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+func (cache *lruCache[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *lruCache[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+func (cache *lruCache[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *lruCache[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+func (cache *lfuCache[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *lfuCache[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+func (cache *lfuCache[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *lfuCache[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+func (cache *arcCache[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *arcCache[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+func (cache *arcCache[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(cache)
+}
+
+func (cache *arcCache[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, cache)
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// writeEntries writes the entries from the last to the first.
+func writeEntries[K, V any](w io.Writer, entries []*entry[K, V]) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, len(entries), func(visit func(snapshot.Pair[K, V]) bool) {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if !visit(snapshot.Pair[K, V]{Key: entries[i].key, Value: entries[i].value}) {
+				return
+			}
+		}
+	})
+	return writer.BytesWritten(), err
+}
+
+// readEntries puts the entries of the snapshot into the empty cache read, whose eviction callback must be nil,
+// calling swap only if all of them fit.
+func readEntries[K, V any](r io.Reader, read Cache[K, V], swap func()) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Read(reader, func(pair snapshot.Pair[K, V]) {
+		read.Put(pair.Key, pair.Value)
+	})
+	if err == nil && read.Stats().Evictions > 0 {
+		err = errCapacity
+	}
+	if err == nil {
+		swap()
+	}
+	return reader.BytesRead(), err
+}
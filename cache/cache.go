@@ -0,0 +1,46 @@
+package cache
+
+// Cache represents an abstract data type for a bounded key-value store that evicts entries, following its
+// replacement policy, to make room for new ones.
+type Cache[K any, V any] interface {
+	// Get returns the value associated with the key and true, or the zero value and false if the key is not
+	// cached. It counts as a hit or a miss, and as a use of the entry for the replacement policy.
+	Get(key K) (V, bool)
+
+	// Put stores the key-value pair, replacing the previous value of the key. If the cache is full, an entry is
+	// evicted first.
+	Put(key K, value V)
+
+	// Peek returns the value associated with the key like Get, but without affecting the statistics nor the
+	// replacement policy.
+	Peek(key K) (V, bool)
+
+	// Remove deletes the key from the cache, returning whether it was cached. Removed entries are not
+	// reported to the eviction callback.
+	Remove(key K) bool
+
+	// Len returns the number of cached entries.
+	Len() int
+
+	// Capacity returns the maximum number of entries the cache can hold.
+	Capacity() int
+
+	// Stats returns the hits, misses and evictions since the cache was created.
+	Stats() Stats
+}
+
+// Stats holds the usage statistics of a Cache.
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 if there were none.
+func (stats Stats) HitRatio() float64 {
+	lookups := stats.Hits + stats.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(lookups)
+}
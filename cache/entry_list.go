@@ -0,0 +1,63 @@
+package cache
+
+// entry is a cached key-value pair that knows its neighbours, so it can be unlinked in O(1).
+type entry[K, V any] struct {
+	key   K
+	value V
+	prev  *entry[K, V]
+	next  *entry[K, V]
+}
+
+// entryList is a circular doubly linked list of entries around a sentinel. The front holds the most
+// recently inserted entry and the back the oldest one.
+type entryList[K, V any] struct {
+	sentinel entry[K, V]
+	length   int
+}
+
+func newEntryList[K, V any]() *entryList[K, V] {
+	list := &entryList[K, V]{}
+	list.sentinel.next = &list.sentinel
+	list.sentinel.prev = &list.sentinel
+	return list
+}
+
+func (list *entryList[K, V]) isEmpty() bool {
+	return list.length == 0
+}
+
+func (list *entryList[K, V]) pushFront(elem *entry[K, V]) {
+	elem.prev = &list.sentinel
+	elem.next = list.sentinel.next
+	list.sentinel.next.prev = elem
+	list.sentinel.next = elem
+	list.length++
+}
+
+func (list *entryList[K, V]) remove(elem *entry[K, V]) {
+	elem.prev.next = elem.next
+	elem.next.prev = elem.prev
+	elem.prev = nil
+	elem.next = nil
+	list.length--
+}
+
+func (list *entryList[K, V]) moveToFront(elem *entry[K, V]) {
+	list.remove(elem)
+	list.pushFront(elem)
+}
+
+// back returns the oldest entry.
+// Pre: The list is not empty.
+func (list *entryList[K, V]) back() *entry[K, V] {
+	return list.sentinel.prev
+}
+
+// entries returns the entries of the list from its front to its back.
+func (list *entryList[K, V]) entries() []*entry[K, V] {
+	entries := make([]*entry[K, V], 0, list.length)
+	for elem := list.sentinel.next; elem != &list.sentinel; elem = elem.next {
+		entries = append(entries, elem)
+	}
+	return entries
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ------------ FORMATTING ------------ //
+
+// String formats the entries from the most to the least recently used, like LRU[capacity: 3, recent first: c:3 a:1].
+func (cache *lruCache[K, V]) String() string {
+	return fmt.Sprintf("LRU[capacity: %d, recent first: %s]", cache.capacity, joinEntries(cache.recency.entries(), "%v:%v", " "))
+}
+
+// GoString formats the entries as Go syntax, in the same order as String, like cache.LRU[string, int]{"c": 3, "a": 1}.
+func (cache *lruCache[K, V]) GoString() string {
+	return goTypeName[K, V]("cache.LRU") + "{" + joinEntries(cache.recency.entries(), "%#v: %#v", ", ") + "}"
+}
+
+// String formats the entries from the most to the least frequently used, and among those used as many times from
+// the most to the least recently used, like LFU[capacity: 3, frequent first: b:2 c:3 a:1].
+func (cache *lfuCache[K, V]) String() string {
+	return fmt.Sprintf("LFU[capacity: %d, frequent first: %s]", cache.capacity, joinEntries(cache.entries(), "%v:%v", " "))
+}
+
+func (cache *lfuCache[K, V]) GoString() string {
+	return goTypeName[K, V]("cache.LFU") + "{" + joinEntries(cache.entries(), "%#v: %#v", ", ") + "}"
+}
+
+// String formats the entries seen once and those seen at least twice, from the most to the least recently used,
+// like ARC[capacity: 3, recent: c:3, frequent: a:1 b:2]. The keys kept as ghosts are left out.
+func (cache *arcCache[K, V]) String() string {
+	return fmt.Sprintf("ARC[capacity: %d, recent: %s, frequent: %s]", cache.capacity,
+		joinEntries(cache.recent.entries(), "%v:%v", " "), joinEntries(cache.frequent.entries(), "%v:%v", " "))
+}
+
+// GoString formats the entries as Go syntax, those seen once first, like cache.ARC[string, int]{"c": 3, "a": 1}.
+func (cache *arcCache[K, V]) GoString() string {
+	entries := append(cache.recent.entries(), cache.frequent.entries()...)
+	return goTypeName[K, V]("cache.ARC") + "{" + joinEntries(entries, "%#v: %#v", ", ") + "}"
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+func joinEntries[K, V any](entries []*entry[K, V], format string, separator string) string {
+	texts := make([]string, len(entries))
+	for i, elem := range entries {
+		texts[i] = fmt.Sprintf(format, elem.key, elem.value)
+	}
+	return strings.Join(texts, separator)
+}
+
+func goTypeName[K, V any](name string) string {
+	return fmt.Sprintf("%s[%v, %v]", name, reflect.TypeFor[K](), reflect.TypeFor[V]())
+}
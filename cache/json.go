@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	errEntry    = errors.New("cache: every entry must be an array with a key and a value")
+	errCapacity = errors.New("cache: the entries do not fit in the capacity of the cache")
+)
+
+// The caches are encoded as a JSON array of [key, value] arrays, from the entry most worth keeping to the least:
+// from the most to the least recently used in an LRU cache, from the most to the least frequently used in an LFU
+// cache, and the entries seen once before those seen at least twice in an ARC cache. Decoding an array puts its
+// entries, from the last to the first, into an empty cache with the same capacity, cmp and eviction callback, so
+// that the first entry ends up as the most recently used one. The entries start over as used once, and the
+// statistics are kept. A JSON null leaves the cache as it is, and an array that cannot be decoded or that holds
+// more entries than the capacity returns an error without changing it.
+
+// ------------ JSON ENCODING ------------ //
+
+func (cache *lruCache[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(cache.recency.entries())
+}
+
+func (cache *lruCache[K, V]) UnmarshalJSON(data []byte) error {
+	read := CreateLRU[K, V](cache.capacity, cache.cmp, nil).(*lruCache[K, V])
+	return unmarshalEntries(data, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+func (cache *lfuCache[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(cache.entries())
+}
+
+func (cache *lfuCache[K, V]) UnmarshalJSON(data []byte) error {
+	read := CreateLFU[K, V](cache.capacity, cache.cmp, nil).(*lfuCache[K, V])
+	return unmarshalEntries(data, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+func (cache *arcCache[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalEntries(append(cache.recent.entries(), cache.frequent.entries()...))
+}
+
+func (cache *arcCache[K, V]) UnmarshalJSON(data []byte) error {
+	read := CreateARC[K, V](cache.capacity, cache.cmp, nil).(*arcCache[K, V])
+	return unmarshalEntries(data, read, func() {
+		read.onEvict, read.stats = cache.onEvict, cache.stats
+		*cache = *read
+	})
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+func marshalEntries[K, V any](entries []*entry[K, V]) ([]byte, error) {
+	pairs := make([][2]any, len(entries))
+	for i, elem := range entries {
+		pairs[i] = [2]any{elem.key, elem.value}
+	}
+	return json.Marshal(pairs)
+}
+
+// unmarshalEntries decodes a JSON array of entries and puts them into the empty cache read, whose eviction callback
+// must be nil, calling swap only if all of them fit.
+func unmarshalEntries[K, V any](data []byte, read Cache[K, V], swap func()) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	entries := make([]entry[K, V], len(raw))
+	for i, element := range raw {
+		var pair []json.RawMessage
+		if err := json.Unmarshal(element, &pair); err != nil || len(pair) != 2 {
+			return errEntry
+		}
+		if err := json.Unmarshal(pair[0], &entries[i].key); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(pair[1], &entries[i].value); err != nil {
+			return err
+		}
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		read.Put(entries[i].key, entries[i].value)
+	}
+	if read.Stats().Evictions > 0 {
+		return errCapacity
+	}
+	swap()
+	return nil
+}
@@ -0,0 +1,187 @@
+package cache
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+// lfuBucket groups the entries used the same number of times. Buckets form a doubly linked list sorted by
+// frequency, so the least frequently used entries are always in the first one.
+type lfuBucket[K, V any] struct {
+	frequency int
+	entries   *entryList[K, V]
+	prev      *lfuBucket[K, V]
+	next      *lfuBucket[K, V]
+}
+
+type lfuItem[K, V any] struct {
+	elem   *entry[K, V]
+	bucket *lfuBucket[K, V]
+}
+
+type lfuCache[K, V any] struct {
+	index    TDADictionary.Dictionary[K, *lfuItem[K, V]]
+	first    *lfuBucket[K, V]
+	length   int
+	capacity int
+	cmp      func(K, K) bool
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE CACHE ------------ //
+
+// CreateLFU creates a cache that evicts the least frequently used entry, breaking ties by evicting the least
+// recently used among them. Every operation takes O(1). Keys are compared with cmp, as in
+// dictionary.CreateHash, and onEvict, if not nil, is called with every evicted entry.
+// If the capacity is not positive, it panics with "The capacity must be positive".
+func CreateLFU[K, V any](capacity int, cmp func(K, K) bool, onEvict func(key K, value V)) Cache[K, V] {
+	if capacity <= 0 {
+		panic(_PANIC_CAPACITY_MSG)
+	}
+	return &lfuCache[K, V]{
+		index:    TDADictionary.CreateHash[K, *lfuItem[K, V]](cmp),
+		capacity: capacity,
+		cmp:      cmp,
+		onEvict:  onEvict,
+	}
+}
+
+// ------------ CACHE PRIMITIVES ------------ //
+
+func (cache *lfuCache[K, V]) Get(key K) (V, bool) {
+	if !cache.index.Belongs(key) {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	item := cache.index.Get(key)
+	cache.touch(item)
+	cache.stats.Hits++
+	return item.elem.value, true
+}
+
+func (cache *lfuCache[K, V]) Put(key K, value V) {
+	if cache.index.Belongs(key) {
+		item := cache.index.Get(key)
+		item.elem.value = value
+		cache.touch(item)
+		return
+	}
+
+	if cache.length == cache.capacity {
+		cache.evict()
+	}
+
+	if cache.first == nil || cache.first.frequency != 1 {
+		cache.insertBucketAfter(nil, 1)
+	}
+	item := &lfuItem[K, V]{elem: &entry[K, V]{key: key, value: value}, bucket: cache.first}
+	item.bucket.entries.pushFront(item.elem)
+	cache.index.Save(key, item)
+	cache.length++
+}
+
+func (cache *lfuCache[K, V]) Peek(key K) (V, bool) {
+	if !cache.index.Belongs(key) {
+		var zero V
+		return zero, false
+	}
+	return cache.index.Get(key).elem.value, true
+}
+
+func (cache *lfuCache[K, V]) Remove(key K) bool {
+	if !cache.index.Belongs(key) {
+		return false
+	}
+	cache.detach(cache.index.Delete(key))
+	return true
+}
+
+func (cache *lfuCache[K, V]) Len() int {
+	return cache.length
+}
+
+func (cache *lfuCache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+func (cache *lfuCache[K, V]) Stats() Stats {
+	return cache.stats
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// touch moves the item to the bucket of the next frequency, creating it if needed.
+func (cache *lfuCache[K, V]) touch(item *lfuItem[K, V]) {
+	current := item.bucket
+	if current.next == nil || current.next.frequency != current.frequency+1 {
+		cache.insertBucketAfter(current, current.frequency+1)
+	}
+
+	item.bucket = current.next
+	current.entries.remove(item.elem)
+	item.bucket.entries.pushFront(item.elem)
+
+	if current.entries.isEmpty() {
+		cache.unlinkBucket(current)
+	}
+}
+
+func (cache *lfuCache[K, V]) evict() {
+	victim := cache.first.entries.back()
+	cache.detach(cache.index.Delete(victim.key))
+	cache.stats.Evictions++
+
+	if cache.onEvict != nil {
+		cache.onEvict(victim.key, victim.value)
+	}
+}
+
+// detach takes the item out of its bucket, dropping the bucket if it becomes empty.
+func (cache *lfuCache[K, V]) detach(item *lfuItem[K, V]) {
+	item.bucket.entries.remove(item.elem)
+	if item.bucket.entries.isEmpty() {
+		cache.unlinkBucket(item.bucket)
+	}
+	cache.length--
+}
+
+// insertBucketAfter creates an empty bucket right after prev, or at the beginning if prev is nil.
+func (cache *lfuCache[K, V]) insertBucketAfter(prev *lfuBucket[K, V], frequency int) {
+	bucket := &lfuBucket[K, V]{frequency: frequency, entries: newEntryList[K, V](), prev: prev}
+	if prev == nil {
+		bucket.next = cache.first
+		cache.first = bucket
+	} else {
+		bucket.next = prev.next
+		prev.next = bucket
+	}
+	if bucket.next != nil {
+		bucket.next.prev = bucket
+	}
+}
+
+func (cache *lfuCache[K, V]) unlinkBucket(bucket *lfuBucket[K, V]) {
+	if bucket.prev == nil {
+		cache.first = bucket.next
+	} else {
+		bucket.prev.next = bucket.next
+	}
+	if bucket.next != nil {
+		bucket.next.prev = bucket.prev
+	}
+}
+
+// entries returns the entries from the bucket of the highest frequency to the first one.
+func (cache *lfuCache[K, V]) entries() []*entry[K, V] {
+	last := cache.first
+	for last != nil && last.next != nil {
+		last = last.next
+	}
+	entries := make([]*entry[K, V], 0, cache.length)
+	for bucket := last; bucket != nil; bucket = bucket.prev {
+		entries = append(entries, bucket.entries.entries()...)
+	}
+	return entries
+}
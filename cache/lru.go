@@ -0,0 +1,107 @@
+package cache
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+const _PANIC_CAPACITY_MSG = "The capacity must be positive"
+
+type lruCache[K, V any] struct {
+	index    TDADictionary.Dictionary[K, *entry[K, V]]
+	recency  *entryList[K, V]
+	capacity int
+	cmp      func(K, K) bool
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE CACHE ------------ //
+
+// CreateLRU creates a cache that evicts the least recently used entry. Keys are compared with cmp, as in
+// dictionary.CreateHash, and onEvict, if not nil, is called with every evicted entry.
+// If the capacity is not positive, it panics with "The capacity must be positive".
+func CreateLRU[K, V any](capacity int, cmp func(K, K) bool, onEvict func(key K, value V)) Cache[K, V] {
+	if capacity <= 0 {
+		panic(_PANIC_CAPACITY_MSG)
+	}
+	return &lruCache[K, V]{
+		index:    TDADictionary.CreateHash[K, *entry[K, V]](cmp),
+		recency:  newEntryList[K, V](),
+		capacity: capacity,
+		cmp:      cmp,
+		onEvict:  onEvict,
+	}
+}
+
+// ------------ CACHE PRIMITIVES ------------ //
+
+func (cache *lruCache[K, V]) Get(key K) (V, bool) {
+	if !cache.index.Belongs(key) {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	elem := cache.index.Get(key)
+	cache.recency.moveToFront(elem)
+	cache.stats.Hits++
+	return elem.value, true
+}
+
+func (cache *lruCache[K, V]) Put(key K, value V) {
+	if cache.index.Belongs(key) {
+		elem := cache.index.Get(key)
+		elem.value = value
+		cache.recency.moveToFront(elem)
+		return
+	}
+
+	if cache.recency.length == cache.capacity {
+		cache.evict()
+	}
+
+	elem := &entry[K, V]{key: key, value: value}
+	cache.recency.pushFront(elem)
+	cache.index.Save(key, elem)
+}
+
+func (cache *lruCache[K, V]) Peek(key K) (V, bool) {
+	if !cache.index.Belongs(key) {
+		var zero V
+		return zero, false
+	}
+	return cache.index.Get(key).value, true
+}
+
+func (cache *lruCache[K, V]) Remove(key K) bool {
+	if !cache.index.Belongs(key) {
+		return false
+	}
+	cache.recency.remove(cache.index.Delete(key))
+	return true
+}
+
+func (cache *lruCache[K, V]) Len() int {
+	return cache.recency.length
+}
+
+func (cache *lruCache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+func (cache *lruCache[K, V]) Stats() Stats {
+	return cache.stats
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+func (cache *lruCache[K, V]) evict() {
+	victim := cache.recency.back()
+	cache.recency.remove(victim)
+	cache.index.Delete(victim.key)
+	cache.stats.Evictions++
+
+	if cache.onEvict != nil {
+		cache.onEvict(victim.key, victim.value)
+	}
+}
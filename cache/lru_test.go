@@ -0,0 +1,126 @@
+package cache_test
+
+import (
+	TDACache "adts/cache"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _PANIC_CAPACITY_MSG = "The capacity must be positive"
+
+func intEquality(a, b int) bool {
+	return a == b
+}
+
+func stringEquality(a, b string) bool {
+	return a == b
+}
+
+func TestLRUInvalidCapacity(t *testing.T) {
+	require.PanicsWithValue(t, _PANIC_CAPACITY_MSG, func() { TDACache.CreateLRU[int, int](0, intEquality, nil) })
+	require.PanicsWithValue(t, _PANIC_CAPACITY_MSG, func() { TDACache.CreateLRU[int, int](-3, intEquality, nil) })
+}
+
+func TestLRUEmpty(t *testing.T) {
+	cache := TDACache.CreateLRU[string, int](2, stringEquality, nil)
+	require.EqualValues(t, 0, cache.Len())
+	require.EqualValues(t, 2, cache.Capacity())
+
+	_, ok := cache.Get("A")
+	require.False(t, ok)
+	_, ok = cache.Peek("A")
+	require.False(t, ok)
+	require.False(t, cache.Remove("A"))
+	require.Equal(t, TDACache.Stats{Misses: 1}, cache.Stats())
+}
+
+func TestLRUGetAndPut(t *testing.T) {
+	cache := TDACache.CreateLRU[string, int](3, stringEquality, nil)
+	cache.Put("A", 1)
+	cache.Put("B", 2)
+	cache.Put("A", 10)
+
+	value, ok := cache.Get("A")
+	require.True(t, ok)
+	require.EqualValues(t, 10, value)
+	require.EqualValues(t, 2, cache.Len())
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	cache := TDACache.CreateLRU[string, int](2, stringEquality, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+	cache.Put("A", 1)
+	cache.Put("B", 2)
+	cache.Get("A")
+	cache.Put("C", 3)
+
+	require.Equal(t, []string{"B"}, evicted)
+	_, ok := cache.Peek("B")
+	require.False(t, ok)
+
+	cache.Put("A", 100)
+	cache.Put("D", 4)
+	require.Equal(t, []string{"B", "C"}, evicted, "Updating a key counts as using it")
+	require.EqualValues(t, 2, cache.Len())
+}
+
+func TestLRUPeekDoesNotChangeRecency(t *testing.T) {
+	cache := TDACache.CreateLRU[int, int](2, intEquality, nil)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+
+	value, ok := cache.Peek(1)
+	require.True(t, ok)
+	require.EqualValues(t, 1, value)
+
+	cache.Put(3, 3)
+	_, ok = cache.Peek(1)
+	require.False(t, ok)
+	require.Equal(t, TDACache.Stats{Evictions: 1}, cache.Stats())
+}
+
+func TestLRURemove(t *testing.T) {
+	evictions := 0
+	cache := TDACache.CreateLRU[int, int](2, intEquality, func(int, int) { evictions++ })
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+
+	require.True(t, cache.Remove(1))
+	require.False(t, cache.Remove(1))
+	require.EqualValues(t, 1, cache.Len())
+
+	cache.Put(3, 3)
+	require.EqualValues(t, 0, evictions, "The removed entry left room for the new one")
+}
+
+func TestLRUStats(t *testing.T) {
+	cache := TDACache.CreateLRU[int, int](10, intEquality, nil)
+	for i := 0; i < 20; i++ {
+		cache.Put(i, i)
+	}
+	for i := 0; i < 20; i++ {
+		cache.Get(i)
+	}
+
+	stats := cache.Stats()
+	require.Equal(t, TDACache.Stats{Hits: 10, Misses: 10, Evictions: 10}, stats)
+	require.InDelta(t, 0.5, stats.HitRatio(), 1e-9)
+	require.Zero(t, TDACache.Stats{}.HitRatio())
+}
+
+func TestLRUVolume(t *testing.T) {
+	const capacity = 1000
+	cache := TDACache.CreateLRU[int, int](capacity, intEquality, nil)
+	for i := 0; i < 100*capacity; i++ {
+		cache.Put(i, i)
+		require.LessOrEqual(t, cache.Len(), capacity)
+	}
+	for i := 99 * capacity; i < 100*capacity; i++ {
+		value, ok := cache.Get(i)
+		require.True(t, ok)
+		require.EqualValues(t, i, value)
+	}
+}
@@ -0,0 +1,301 @@
+package cache_test
+
+import (
+	TDACache "adts/cache"
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type cacheConstructor func(capacity int, onEvict func(int, int)) TDACache.Cache[int, int]
+
+var policies = map[string]cacheConstructor{
+	"LRU": func(capacity int, onEvict func(int, int)) TDACache.Cache[int, int] {
+		return TDACache.CreateLRU(capacity, intEquality, onEvict)
+	},
+	"LFU": func(capacity int, onEvict func(int, int)) TDACache.Cache[int, int] {
+		return TDACache.CreateLFU(capacity, intEquality, onEvict)
+	},
+	"ARC": func(capacity int, onEvict func(int, int)) TDACache.Cache[int, int] {
+		return TDACache.CreateARC(capacity, intEquality, onEvict)
+	},
+}
+
+// ------------------------- SHARED BEHAVIOUR -------------------------
+
+func TestPoliciesInvalidCapacity(t *testing.T) {
+	for name, create := range policies {
+		t.Run(name, func(t *testing.T) {
+			require.PanicsWithValue(t, _PANIC_CAPACITY_MSG, func() { create(0, nil) })
+		})
+	}
+}
+
+func TestPoliciesBasicOperations(t *testing.T) {
+	for name, create := range policies {
+		t.Run(name, func(t *testing.T) {
+			cache := create(3, nil)
+			cache.Put(1, 10)
+			cache.Put(2, 20)
+			cache.Put(1, 11)
+
+			value, ok := cache.Get(1)
+			require.True(t, ok)
+			require.EqualValues(t, 11, value)
+			value, ok = cache.Peek(2)
+			require.True(t, ok)
+			require.EqualValues(t, 20, value)
+			_, ok = cache.Get(3)
+			require.False(t, ok)
+
+			require.EqualValues(t, 2, cache.Len())
+			require.EqualValues(t, 3, cache.Capacity())
+			require.Equal(t, TDACache.Stats{Hits: 1, Misses: 1}, cache.Stats())
+
+			require.True(t, cache.Remove(1))
+			require.False(t, cache.Remove(1))
+			_, ok = cache.Peek(1)
+			require.False(t, ok)
+			require.EqualValues(t, 1, cache.Len())
+		})
+	}
+}
+
+func TestPoliciesRespectCapacity(t *testing.T) {
+	for name, create := range policies {
+		t.Run(name, func(t *testing.T) {
+			evicted := 0
+			cache := create(50, func(key int, value int) {
+				require.EqualValues(t, 2*key, value)
+				evicted++
+			})
+			rng := rand.New(rand.NewSource(3))
+			for i := 0; i < 20000; i++ {
+				key := rng.Intn(200)
+				if _, ok := cache.Get(key); !ok {
+					cache.Put(key, 2*key)
+				}
+				if i%97 == 0 {
+					cache.Remove(rng.Intn(200))
+				}
+				require.LessOrEqual(t, cache.Len(), 50)
+			}
+			require.EqualValues(t, evicted, cache.Stats().Evictions)
+		})
+	}
+}
+
+// ------------------------- POLICY SPECIFICS -------------------------
+
+func TestLFUEvictsLeastFrequentlyUsed(t *testing.T) {
+	var evicted []int
+	cache := TDACache.CreateLFU(3, intEquality, func(key int, _ int) { evicted = append(evicted, key) })
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	cache.Get(1)
+	cache.Get(1)
+	cache.Get(2)
+	cache.Get(3)
+
+	cache.Put(4, 4)
+	require.Equal(t, []int{2}, evicted, "2 and 3 were used the same times, but 2 less recently")
+
+	cache.Put(5, 5)
+	require.Equal(t, []int{2, 4}, evicted, "New entries are the least frequently used")
+	_, ok := cache.Peek(1)
+	require.True(t, ok)
+}
+
+func TestARCKeepsFrequentEntriesDuringScan(t *testing.T) {
+	cache := TDACache.CreateARC[int, int](4, intEquality, nil)
+	for _, key := range []int{1, 2, 1, 2} {
+		if _, ok := cache.Get(key); !ok {
+			cache.Put(key, key)
+		}
+	}
+	for key := 100; key < 120; key++ {
+		cache.Put(key, key)
+	}
+
+	_, ok := cache.Peek(1)
+	require.True(t, ok, "Entries used twice survive a scan of new keys")
+	_, ok = cache.Peek(2)
+	require.True(t, ok, "Entries used twice survive a scan of new keys")
+}
+
+func TestARCAdaptsOnGhostHits(t *testing.T) {
+	cache := TDACache.CreateARC[int, int](2, intEquality, nil)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	_, ok := cache.Peek(1)
+	require.False(t, ok)
+
+	cache.Put(1, 10)
+	value, ok := cache.Peek(1)
+	require.True(t, ok, "A key evicted recently comes back")
+	require.EqualValues(t, 10, value)
+	require.EqualValues(t, 2, cache.Len())
+}
+
+// ---------------------------- TRACE REPLAY ----------------------------
+
+// scanHeavyTrace mixes requests to a small hot set with long sequential scans over keys that never repeat.
+func scanHeavyTrace(length int) []int {
+	rng := rand.New(rand.NewSource(2024))
+	trace := make([]int, 0, length)
+	nextScanKey := 1_000_000
+	for len(trace) < length {
+		if rng.Intn(1000) == 0 {
+			for i := 0; i < 500 && len(trace) < length; i++ {
+				trace = append(trace, nextScanKey)
+				nextScanKey++
+			}
+			continue
+		}
+		trace = append(trace, rng.Intn(150))
+	}
+	return trace
+}
+
+// replay runs the trace on the cache as a read-through cache would, loading every missed key.
+func replay(cache TDACache.Cache[int, int], trace []int) TDACache.Stats {
+	for _, key := range trace {
+		if _, ok := cache.Get(key); !ok {
+			cache.Put(key, key)
+		}
+	}
+	return cache.Stats()
+}
+
+func TestTraceReplayHitRatios(t *testing.T) {
+	trace := scanHeavyTrace(100000)
+	ratios := map[string]float64{}
+	for _, name := range []string{"LRU", "LFU", "ARC"} {
+		stats := replay(policies[name](200, nil), trace)
+		ratios[name] = stats.HitRatio()
+		t.Logf("%s: hit ratio %.4f (%d hits, %d misses, %d evictions)",
+			name, stats.HitRatio(), stats.Hits, stats.Misses, stats.Evictions)
+		require.EqualValues(t, len(trace), stats.Hits+stats.Misses)
+	}
+
+	require.Greater(t, ratios["LFU"], ratios["LRU"], "LFU should resist the scans better than LRU")
+	require.Greater(t, ratios["ARC"], ratios["LRU"], "ARC should resist the scans better than LRU")
+}
+
+func TestFormat(t *testing.T) {
+	lru := TDACache.CreateLRU[string, int](3, stringEquality, nil)
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("a")
+	require.Equal(t, "LRU[capacity: 3, recent first: a:1 c:3 b:2]", fmt.Sprint(lru))
+	require.Equal(t, `cache.LRU[string, int]{"a": 1, "c": 3, "b": 2}`, fmt.Sprintf("%#v", lru))
+
+	lfu := TDACache.CreateLFU[string, int](3, stringEquality, nil)
+	lfu.Put("a", 1)
+	lfu.Put("b", 2)
+	lfu.Put("c", 3)
+	lfu.Get("b")
+	lfu.Get("b")
+	lfu.Get("a")
+	require.Equal(t, "LFU[capacity: 3, frequent first: b:2 a:1 c:3]", fmt.Sprint(lfu))
+	require.Equal(t, `cache.LFU[string, int]{"b": 2, "a": 1, "c": 3}`, fmt.Sprintf("%#v", lfu))
+
+	arc := TDACache.CreateARC[string, int](3, stringEquality, nil)
+	arc.Put("a", 1)
+	arc.Put("b", 2)
+	arc.Get("a")
+	require.Equal(t, "ARC[capacity: 3, recent: b:2, frequent: a:1]", fmt.Sprint(arc))
+	require.Equal(t, `cache.ARC[string, int]{"b": 2, "a": 1}`, fmt.Sprintf("%#v", arc))
+
+	require.Equal(t, TDACache.Stats{Hits: 1}, arc.Stats(), "Formatting is not a lookup")
+}
+
+func TestPoliciesJSON(t *testing.T) {
+	for name, create := range policies {
+		t.Run(name, func(t *testing.T) {
+			cache := create(3, nil)
+			data, err := json.Marshal(cache)
+			require.NoError(t, err)
+			require.Equal(t, `[]`, string(data))
+
+			cache.Put(1, 10)
+			cache.Put(2, 20)
+			cache.Put(3, 30)
+			data, err = json.Marshal(cache)
+			require.NoError(t, err)
+			require.Equal(t, `[[3,30],[2,20],[1,10]]`, string(data))
+
+			var evicted []int
+			copied := create(3, func(key, _ int) { evicted = append(evicted, key) })
+			copied.Put(4, 40)
+			copied.Get(5)
+			require.NoError(t, json.Unmarshal(data, copied))
+			require.EqualValues(t, 3, copied.Len())
+			require.Equal(t, TDACache.Stats{Misses: 1}, copied.Stats(), "The statistics are kept")
+			_, ok := copied.Peek(4)
+			require.False(t, ok)
+			copied.Put(4, 40)
+			require.Equal(t, []int{1}, evicted, "The first entry to evict is the last one of the array")
+			copied.Remove(4)
+
+			require.Equal(t, `[[3,30],[2,20]]`, mustMarshal(t, copied))
+
+			require.NoError(t, json.Unmarshal([]byte(`null`), copied))
+			require.Error(t, json.Unmarshal([]byte(`[[1,"ten"]]`), copied))
+			require.Error(t, json.Unmarshal([]byte(`[[1]]`), copied))
+			require.Error(t, json.Unmarshal([]byte(`[[1,1],[2,2],[3,3],[4,4]]`), copied), "More entries than the capacity")
+			require.Equal(t, `[[3,30],[2,20]]`, mustMarshal(t, copied), "A failed decoding changes nothing")
+			require.Equal(t, []int{1}, evicted)
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestPoliciesBinary(t *testing.T) {
+	for name, create := range policies {
+		t.Run(name, func(t *testing.T) {
+			cache := create(100, nil)
+			for i := 0; i < 150; i++ {
+				cache.Put(i, i*i)
+			}
+			for i := 60; i < 80; i++ {
+				cache.Get(i)
+			}
+			var buffer bytes.Buffer
+			require.NoError(t, gob.NewEncoder(&buffer).Encode(cache))
+			data := buffer.Bytes()
+
+			for other, createOther := range policies {
+				copied := createOther(100, nil)
+				copied.Get(-1)
+				require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied), "%s into %s", name, other)
+				require.Equal(t, mustMarshal(t, cache), mustMarshal(t, copied), "%s into %s", name, other)
+				require.Equal(t, TDACache.Stats{Misses: 1}, copied.Stats(), "%s into %s", name, other)
+			}
+
+			var evicted []int
+			small := create(10, func(key, _ int) { evicted = append(evicted, key) })
+			small.Put(-1, 1)
+			binary, err := cache.(encoding.BinaryMarshaler).MarshalBinary()
+			require.NoError(t, err)
+			require.Error(t, small.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary), "More entries than the capacity")
+			require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(small))
+			require.Equal(t, `[[-1,1]]`, mustMarshal(t, small), "A failed read changes nothing")
+			require.Empty(t, evicted)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package dictionary
+
+import (
+	TDAStack "adts/stack"
+)
+
+const _PANIC_MESSAGE_EMPTY = "The dictionary is empty"
+
+type avlNode[K, V any] struct {
+	key    K
+	value  V
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+	height int
+}
+
+type avlTree[K, V any] struct {
+	root  *avlNode[K, V]
+	count int
+	cmp   func(K, K) int
+}
+
+type iterAVL[K, V any] struct {
+	tree  *avlTree[K, V]
+	stack TDAStack.Stack[*avlNode[K, V]]
+	from  *K
+	to    *K
+}
+
+// CreateAVL creates an ordered dictionary backed by an AVL tree. cmp(a, b) must be negative if a goes before b,
+// zero if they are the same key, and positive otherwise
+func CreateAVL[K, V any](cmp func(K, K) int) OrderedDictionary[K, V] {
+	return &avlTree[K, V]{cmp: cmp}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (tree *avlTree[K, V]) Save(key K, value V) {
+	tree.root = tree.insert(tree.root, key, value)
+}
+
+func (tree *avlTree[K, V]) Belongs(key K) bool {
+	return tree.search(key) != nil
+}
+
+func (tree *avlTree[K, V]) Get(key K) V {
+	node := tree.search(key)
+	if node == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return node.value
+}
+
+func (tree *avlTree[K, V]) Delete(key K) V {
+	var value V
+	tree.root = tree.remove(tree.root, key, &value)
+	return value
+}
+
+func (tree *avlTree[K, V]) Count() int {
+	return tree.count
+}
+
+func (tree *avlTree[K, V]) Iterate(visit func(key K, value V) bool) {
+	tree.IterateRange(nil, nil, visit)
+}
+
+func (tree *avlTree[K, V]) Iterator() DictionaryIterator[K, V] {
+	return tree.IteratorRange(nil, nil)
+}
+
+func (tree *avlTree[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	tree.iterateRange(tree.root, from, to, visit)
+}
+
+func (tree *avlTree[K, V]) IteratorRange(from *K, to *K) DictionaryIterator[K, V] {
+	iter := &iterAVL[K, V]{tree: tree, stack: TDAStack.NewDynamicStack[*avlNode[K, V]](), from: from, to: to}
+	iter.pushLeftBranch(tree.root)
+	return iter
+}
+
+func (tree *avlTree[K, V]) Floor(key K) (K, V, bool) {
+	var candidate *avlNode[K, V]
+	current := tree.root
+	for current != nil {
+		comparison := tree.cmp(key, current.key)
+		if comparison == 0 {
+			return current.key, current.value, true
+		}
+		if comparison < 0 {
+			current = current.left
+		} else {
+			candidate, current = current, current.right
+		}
+	}
+	return candidate.pair()
+}
+
+func (tree *avlTree[K, V]) Ceiling(key K) (K, V, bool) {
+	var candidate *avlNode[K, V]
+	current := tree.root
+	for current != nil {
+		comparison := tree.cmp(key, current.key)
+		if comparison == 0 {
+			return current.key, current.value, true
+		}
+		if comparison < 0 {
+			candidate, current = current, current.left
+		} else {
+			current = current.right
+		}
+	}
+	return candidate.pair()
+}
+
+func (tree *avlTree[K, V]) Min() (K, V) {
+	if tree.root == nil {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	current := tree.root
+	for current.left != nil {
+		current = current.left
+	}
+	return current.key, current.value
+}
+
+func (tree *avlTree[K, V]) Max() (K, V) {
+	if tree.root == nil {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	current := tree.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.key, current.value
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterAVL[K, V]) HasNext() bool {
+	if iter.stack.IsEmpty() {
+		return false
+	}
+	return iter.to == nil || iter.tree.cmp(iter.stack.Top().key, *iter.to) <= 0
+}
+
+func (iter *iterAVL[K, V]) Current() (K, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	node := iter.stack.Top()
+	return node.key, node.value
+}
+
+func (iter *iterAVL[K, V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	node := iter.stack.Pop()
+	iter.pushLeftBranch(node.right)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Dictionary functions
+
+func (tree *avlTree[K, V]) search(key K) *avlNode[K, V] {
+	current := tree.root
+	for current != nil {
+		comparison := tree.cmp(key, current.key)
+		if comparison == 0 {
+			return current
+		}
+		if comparison < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return nil
+}
+
+func (tree *avlTree[K, V]) insert(node *avlNode[K, V], key K, value V) *avlNode[K, V] {
+	if node == nil {
+		tree.count++
+		return &avlNode[K, V]{key: key, value: value, height: 1}
+	}
+
+	comparison := tree.cmp(key, node.key)
+	switch {
+	case comparison < 0:
+		node.left = tree.insert(node.left, key, value)
+	case comparison > 0:
+		node.right = tree.insert(node.right, key, value)
+	default:
+		node.value = value
+		return node
+	}
+
+	return rebalance(node)
+}
+
+func (tree *avlTree[K, V]) remove(node *avlNode[K, V], key K, value *V) *avlNode[K, V] {
+	if node == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+
+	comparison := tree.cmp(key, node.key)
+	switch {
+	case comparison < 0:
+		node.left = tree.remove(node.left, key, value)
+	case comparison > 0:
+		node.right = tree.remove(node.right, key, value)
+	default:
+		*value = node.value
+		tree.count--
+
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		// The node takes the place of its successor, which is removed from the right subtree
+		var successor *avlNode[K, V]
+		node.right = removeMin(node.right, &successor)
+		node.key, node.value = successor.key, successor.value
+	}
+
+	return rebalance(node)
+}
+
+// pair returns the key and value of the node and true, or zero values and false if the node is nil
+func (node *avlNode[K, V]) pair() (K, V, bool) {
+	if node == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return node.key, node.value, true
+}
+
+func removeMin[K, V any](node *avlNode[K, V], minNode **avlNode[K, V]) *avlNode[K, V] {
+	if node.left == nil {
+		*minNode = node
+		return node.right
+	}
+	node.left = removeMin(node.left, minNode)
+	return rebalance(node)
+}
+
+func (tree *avlTree[K, V]) iterateRange(node *avlNode[K, V], from *K, to *K, visit func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	afterFrom := from == nil || tree.cmp(node.key, *from) >= 0
+	beforeTo := to == nil || tree.cmp(node.key, *to) <= 0
+
+	if afterFrom && !tree.iterateRange(node.left, from, to, visit) {
+		return false
+	}
+	if afterFrom && beforeTo && !visit(node.key, node.value) {
+		return false
+	}
+	if beforeTo {
+		return tree.iterateRange(node.right, from, to, visit)
+	}
+	return true
+}
+
+// Balancing functions
+
+func height[K, V any](node *avlNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func updateHeight[K, V any](node *avlNode[K, V]) {
+	node.height = 1 + max(height(node.left), height(node.right))
+}
+
+func balanceFactor[K, V any](node *avlNode[K, V]) int {
+	return height(node.left) - height(node.right)
+}
+
+func rotateRight[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	updateHeight(node)
+	updateHeight(pivot)
+	return pivot
+}
+
+func rotateLeft[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	updateHeight(node)
+	updateHeight(pivot)
+	return pivot
+}
+
+func rebalance[K, V any](node *avlNode[K, V]) *avlNode[K, V] {
+	updateHeight(node)
+
+	switch balance := balanceFactor(node); {
+	case balance > 1:
+		if balanceFactor(node.left) < 0 {
+			node.left = rotateLeft(node.left)
+		}
+		return rotateRight(node)
+	case balance < -1:
+		if balanceFactor(node.right) > 0 {
+			node.right = rotateRight(node.right)
+		}
+		return rotateLeft(node)
+	}
+	return node
+}
+
+// External iterator functions
+
+// pushLeftBranch stacks the leftmost path of the subtree, skipping the nodes that are before the lower bound
+func (iter *iterAVL[K, V]) pushLeftBranch(node *avlNode[K, V]) {
+	for node != nil {
+		if iter.from != nil && iter.tree.cmp(node.key, *iter.from) < 0 {
+			node = node.right
+			continue
+		}
+		iter.stack.Push(node)
+		node = node.left
+	}
+}
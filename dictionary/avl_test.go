@@ -0,0 +1,204 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intCompare(a, b int) int {
+	return a - b
+}
+
+func TestAVLEmptyDictionary(t *testing.T) {
+	t.Log("Check that an empty AVL has no keys")
+	dict := TDADictionary.CreateAVL[string, string](strings.Compare)
+	require.EqualValues(t, 0, dict.Count())
+	require.False(t, dict.Belongs("A"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("A") })
+
+	iter := dict.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Current() })
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Next() })
+}
+
+func TestAVLSaveReplaceAndDelete(t *testing.T) {
+	t.Log("Save, replace and delete some keys, checking that the AVL behaves appropriately")
+	dict := TDADictionary.CreateAVL[string, string](strings.Compare)
+	dict.Save("Cat", "meow")
+	dict.Save("Dog", "woof")
+	dict.Save("Cow", "moo")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "woof", dict.Get("Dog"))
+
+	dict.Save("Dog", "bark")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "bark", dict.Get("Dog"))
+
+	require.EqualValues(t, "meow", dict.Delete("Cat"))
+	require.False(t, dict.Belongs("Cat"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("Cat") })
+	require.EqualValues(t, 2, dict.Count())
+	require.True(t, dict.Belongs("Cow"))
+	require.True(t, dict.Belongs("Dog"))
+}
+
+func TestAVLIteratesInOrder(t *testing.T) {
+	t.Log("Both iterators traverse the keys in ascending order")
+	dict := TDADictionary.CreateAVL[int, string](intCompare)
+	for _, key := range []int{50, 20, 80, 10, 30, 70, 90, 60} {
+		dict.Save(key, fmt.Sprint(key))
+	}
+
+	var internal []int
+	dict.Iterate(func(key int, value string) bool {
+		require.EqualValues(t, fmt.Sprint(key), value)
+		internal = append(internal, key)
+		return true
+	})
+
+	var external []int
+	for iter := dict.Iterator(); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		external = append(external, key)
+	}
+
+	expected := []int{10, 20, 30, 50, 60, 70, 80, 90}
+	require.Equal(t, expected, internal)
+	require.Equal(t, expected, external)
+}
+
+func TestAVLRanges(t *testing.T) {
+	t.Log("Range iteration only visits the keys between both bounds, which may be open")
+	dict := TDADictionary.CreateAVL[int, int](intCompare)
+	for i := 0; i < 100; i += 5 {
+		dict.Save(i, i)
+	}
+
+	collect := func(from, to *int) ([]int, []int) {
+		var internal, external []int
+		dict.IterateRange(from, to, func(key int, _ int) bool {
+			internal = append(internal, key)
+			return true
+		})
+		for iter := dict.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+			key, _ := iter.Current()
+			external = append(external, key)
+		}
+		return internal, external
+	}
+
+	from, to := 12, 31
+	internal, external := collect(&from, &to)
+	require.Equal(t, []int{15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	internal, external = collect(nil, &to)
+	require.Equal(t, []int{0, 5, 10, 15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	from = 85
+	internal, external = collect(&from, nil)
+	require.Equal(t, []int{85, 90, 95}, internal)
+	require.Equal(t, internal, external)
+
+	from, to = 41, 44
+	internal, external = collect(&from, &to)
+	require.Empty(t, internal)
+	require.Empty(t, external)
+}
+
+func TestAVLIterateRangeCutoff(t *testing.T) {
+	t.Log("The internal range iterator stops as soon as visit returns false")
+	dict := TDADictionary.CreateAVL[int, int](intCompare)
+	for i := 0; i < 1000; i++ {
+		dict.Save(i, i)
+	}
+
+	from := 100
+	visited := 0
+	dict.IterateRange(&from, nil, func(key int, _ int) bool {
+		visited++
+		return key < 109
+	})
+	require.EqualValues(t, 10, visited)
+}
+
+func TestAVLRandomOperations(t *testing.T) {
+	t.Log("Random saves and deletes are checked against a builtin map, and the iteration stays sorted")
+	rng := rand.New(rand.NewSource(7))
+	dict := TDADictionary.CreateAVL[int, int](intCompare)
+	reference := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		key := rng.Intn(2000)
+		if _, ok := reference[key]; ok && rng.Intn(2) == 0 {
+			require.EqualValues(t, reference[key], dict.Delete(key))
+			delete(reference, key)
+		} else {
+			dict.Save(key, i)
+			reference[key] = i
+		}
+	}
+
+	require.EqualValues(t, len(reference), dict.Count())
+	previous := -1
+	dict.Iterate(func(key int, value int) bool {
+		require.Less(t, previous, key)
+		require.EqualValues(t, reference[key], value)
+		previous = key
+		return true
+	})
+}
+
+func BenchmarkAVL(b *testing.B) {
+	b.Log("AVL stress test, saving, getting and deleting different amounts of elements")
+	for _, n := range VOLUME_SIZES {
+		b.Run(fmt.Sprintf("Test %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dict := TDADictionary.CreateAVL[string, int](strings.Compare)
+				for j := 0; j < n; j++ {
+					dict.Save(fmt.Sprintf("%08d", j), j)
+				}
+				for j := 0; j < n; j++ {
+					require.EqualValues(b, j, dict.Delete(fmt.Sprintf("%08d", j)))
+				}
+				require.EqualValues(b, 0, dict.Count())
+			}
+		})
+	}
+}
+
+func TestAVLFloorAndCeiling(t *testing.T) {
+	t.Log("Floor and Ceiling find the closest keys on each side, or report that there is none")
+	dict := TDADictionary.CreateAVL[int, string](intCompare)
+	for i := 10; i <= 50; i += 10 {
+		dict.Save(i, fmt.Sprint(i))
+	}
+
+	key, value, found := dict.Floor(35)
+	require.True(t, found)
+	require.EqualValues(t, 30, key)
+	require.EqualValues(t, "30", value)
+	key, _, found = dict.Floor(40)
+	require.True(t, found)
+	require.EqualValues(t, 40, key)
+	_, _, found = dict.Floor(9)
+	require.False(t, found)
+
+	key, value, found = dict.Ceiling(35)
+	require.True(t, found)
+	require.EqualValues(t, 40, key)
+	require.EqualValues(t, "40", value)
+	key, _, found = dict.Ceiling(10)
+	require.True(t, found)
+	require.EqualValues(t, 10, key)
+	_, _, found = dict.Ceiling(51)
+	require.False(t, found)
+}
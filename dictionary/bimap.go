@@ -0,0 +1,102 @@
+package dictionary
+
+const (
+	_PANIC_MESSAGE_BIMAP_VALUE    = "The value does not belong to the dictionary"
+	_PANIC_MESSAGE_BIMAP_CONFLICT = "The value already belongs to another key"
+)
+
+// biMap keeps two hashes that are always updated together. The inverse view is another biMap over the same
+// hashes, swapped
+type biMap[K, V any] struct {
+	forward  Dictionary[K, V]
+	backward Dictionary[V, K]
+	keyCmp   func(K, K) bool
+	valueCmp func(V, V) bool
+	policy   ConflictPolicy
+}
+
+// CreateBiMap creates a BiMap that enforces uniqueness of both keys and values. keyCmp and valueCmp compare them
+// as in CreateHash, and policy decides what Save does when a value already belongs to another key
+func CreateBiMap[K, V any](keyCmp func(K, K) bool, valueCmp func(V, V) bool, policy ConflictPolicy) BiMap[K, V] {
+	return &biMap[K, V]{
+		forward:  CreateHash[K, V](keyCmp),
+		backward: CreateHash[V, K](valueCmp),
+		keyCmp:   keyCmp,
+		valueCmp: valueCmp,
+		policy:   policy,
+	}
+}
+
+// -------------------- BIMAP PRIMITIVES --------------------
+
+func (bimap *biMap[K, V]) Save(key K, value V) {
+	if bimap.backward.Belongs(value) {
+		owner := bimap.backward.Get(value)
+		if bimap.keyCmp(owner, key) {
+			return
+		}
+		if bimap.policy == PanicOnConflict {
+			panic(_PANIC_MESSAGE_BIMAP_CONFLICT)
+		}
+		bimap.forward.Delete(owner)
+	}
+
+	if bimap.forward.Belongs(key) {
+		bimap.backward.Delete(bimap.forward.Get(key))
+	}
+
+	bimap.forward.Save(key, value)
+	bimap.backward.Save(value, key)
+}
+
+func (bimap *biMap[K, V]) BelongsKey(key K) bool {
+	return bimap.forward.Belongs(key)
+}
+
+func (bimap *biMap[K, V]) BelongsValue(value V) bool {
+	return bimap.backward.Belongs(value)
+}
+
+func (bimap *biMap[K, V]) GetByKey(key K) V {
+	return bimap.forward.Get(key)
+}
+
+func (bimap *biMap[K, V]) GetByValue(value V) K {
+	if !bimap.backward.Belongs(value) {
+		panic(_PANIC_MESSAGE_BIMAP_VALUE)
+	}
+	return bimap.backward.Get(value)
+}
+
+func (bimap *biMap[K, V]) DeleteByKey(key K) V {
+	value := bimap.forward.Delete(key)
+	bimap.backward.Delete(value)
+	return value
+}
+
+func (bimap *biMap[K, V]) DeleteByValue(value V) K {
+	if !bimap.backward.Belongs(value) {
+		panic(_PANIC_MESSAGE_BIMAP_VALUE)
+	}
+	key := bimap.backward.Delete(value)
+	bimap.forward.Delete(key)
+	return key
+}
+
+func (bimap *biMap[K, V]) Count() int {
+	return bimap.forward.Count()
+}
+
+func (bimap *biMap[K, V]) Iterate(visit func(key K, value V) bool) {
+	bimap.forward.Iterate(visit)
+}
+
+func (bimap *biMap[K, V]) Inverse() BiMap[V, K] {
+	return &biMap[V, K]{
+		forward:  bimap.backward,
+		backward: bimap.forward,
+		keyCmp:   bimap.valueCmp,
+		valueCmp: bimap.keyCmp,
+		policy:   bimap.policy,
+	}
+}
@@ -0,0 +1,120 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBiMapEmpty(t *testing.T) {
+	t.Log("Check that an empty BiMap has neither keys nor values")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	require.EqualValues(t, 0, bimap.Count())
+	require.False(t, bimap.BelongsKey(1))
+	require.False(t, bimap.BelongsValue("A"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { bimap.GetByKey(1) })
+	require.PanicsWithValue(t, "The value does not belong to the dictionary", func() { bimap.GetByValue("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { bimap.DeleteByKey(1) })
+	require.PanicsWithValue(t, "The value does not belong to the dictionary", func() { bimap.DeleteByValue("A") })
+}
+
+func TestBiMapLookupsBothWays(t *testing.T) {
+	t.Log("Every pair can be found by its key and by its value")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	bimap.Save(1, "Bruno")
+	bimap.Save(2, "Abril")
+
+	require.EqualValues(t, 2, bimap.Count())
+	require.EqualValues(t, "Bruno", bimap.GetByKey(1))
+	require.EqualValues(t, 2, bimap.GetByValue("Abril"))
+	require.True(t, bimap.BelongsValue("Bruno"))
+	require.False(t, bimap.BelongsValue("Rocco"))
+}
+
+func TestBiMapReplacingValueOfKey(t *testing.T) {
+	t.Log("Saving an existing key releases its previous value")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	bimap.Save(1, "Bruno")
+	bimap.Save(1, "Abril")
+
+	require.EqualValues(t, 1, bimap.Count())
+	require.False(t, bimap.BelongsValue("Bruno"))
+	require.EqualValues(t, 1, bimap.GetByValue("Abril"))
+
+	bimap.Save(1, "Abril")
+	require.EqualValues(t, 1, bimap.Count())
+}
+
+func TestBiMapConflictPolicies(t *testing.T) {
+	t.Log("A value that belongs to another key panics or takes over, depending on the policy")
+	strict := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	strict.Save(1, "Bruno")
+	strict.Save(2, "Abril")
+	require.PanicsWithValue(t, "The value already belongs to another key", func() { strict.Save(2, "Bruno") })
+	require.EqualValues(t, "Abril", strict.GetByKey(2), "A rejected save leaves the BiMap untouched")
+	require.EqualValues(t, 1, strict.GetByValue("Bruno"))
+
+	lenient := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.OverwriteOnConflict)
+	lenient.Save(1, "Bruno")
+	lenient.Save(2, "Abril")
+	lenient.Save(2, "Bruno")
+	require.EqualValues(t, 1, lenient.Count())
+	require.False(t, lenient.BelongsKey(1))
+	require.False(t, lenient.BelongsValue("Abril"))
+	require.EqualValues(t, 2, lenient.GetByValue("Bruno"))
+}
+
+func TestBiMapDeletionsKeepBothSidesInSync(t *testing.T) {
+	t.Log("Deleting by key or by value removes the whole pair")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	bimap.Save(1, "Bruno")
+	bimap.Save(2, "Abril")
+
+	require.EqualValues(t, "Bruno", bimap.DeleteByKey(1))
+	require.False(t, bimap.BelongsValue("Bruno"))
+	require.EqualValues(t, 2, bimap.DeleteByValue("Abril"))
+	require.False(t, bimap.BelongsKey(2))
+	require.EqualValues(t, 0, bimap.Count())
+
+	bimap.Save(3, "Bruno")
+	require.EqualValues(t, 3, bimap.GetByValue("Bruno"))
+}
+
+func TestBiMapInverseIsLiveView(t *testing.T) {
+	t.Log("The inverse swaps keys and values, and shares changes with the original BiMap")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.PanicOnConflict)
+	bimap.Save(1, "Bruno")
+	inverse := bimap.Inverse()
+
+	require.EqualValues(t, 1, inverse.GetByKey("Bruno"))
+	require.EqualValues(t, "Bruno", inverse.GetByValue(1))
+
+	inverse.Save("Abril", 2)
+	require.EqualValues(t, "Abril", bimap.GetByKey(2))
+	require.PanicsWithValue(t, "The value already belongs to another key", func() { inverse.Save("Rocco", 1) })
+
+	bimap.DeleteByKey(1)
+	require.False(t, inverse.BelongsKey("Bruno"))
+	require.EqualValues(t, 1, inverse.Count())
+	require.EqualValues(t, 2, inverse.Inverse().GetByValue("Abril"))
+}
+
+func TestBiMapVolume(t *testing.T) {
+	t.Log("Many pairs stay consistent on both sides")
+	bimap := TDADictionary.CreateBiMap[int, string](intEquality, stringEquality, TDADictionary.OverwriteOnConflict)
+	for i := 0; i < 10000; i++ {
+		bimap.Save(i, fmt.Sprintf("%05d", i))
+	}
+	for i := 0; i < 10000; i += 2 {
+		bimap.Save(i+1, fmt.Sprintf("%05d", i))
+	}
+
+	require.EqualValues(t, 5000, bimap.Count())
+	bimap.Iterate(func(key int, value string) bool {
+		require.EqualValues(t, fmt.Sprintf("%05d", key-1), value)
+		require.EqualValues(t, key, bimap.GetByValue(value))
+		return true
+	})
+}
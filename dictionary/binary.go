@@ -0,0 +1,359 @@
+package dictionary
+
+import (
+	TDAList "adts/list"
+	"adts/snapshot"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	_SNAPSHOT_DICTIONARY = "dictionary"
+	_SNAPSHOT_MULTI      = "multidictionary"
+	_SNAPSHOT_BAG        = "bag"
+	_SNAPSHOT_EXPIRING   = "expiringdictionary"
+)
+
+// expiringValue is a value of an ExpiringDictionary as it is written in a snapshot, with the zero time if it never
+// expires
+type expiringValue[V any] struct {
+	Value    V
+	ExpireAt time.Time
+}
+
+// WriteDictionary writes a binary snapshot of the pairs of the dictionary, in the order Iterate visits them,
+// encoding keys and values with encoding/gob. The dictionaries of this package use it as their WriteTo method, and
+// other implementations of Dictionary can do the same
+func WriteDictionary[K, V any](w io.Writer, dict Dictionary[K, V]) (int64, error) {
+	return writePairs(w, _SNAPSHOT_DICTIONARY, dict.Count(), dict.Iterate)
+}
+
+// ReadDictionary saves in the dictionary every pair of a snapshot written by WriteDictionary, reading nothing past
+// its end. The pairs read before an error are saved as well, so the dictionary is usually a new one that replaces
+// the old one only if there is no error
+func ReadDictionary[K, V any](r io.Reader, dict Dictionary[K, V]) (int64, error) {
+	return readPairs(r, _SNAPSHOT_DICTIONARY, func(key K, value V) error {
+		dict.Save(key, value)
+		return nil
+	})
+}
+
+// -------------------- BINARY PRIMITIVES --------------------
+
+func (hash *openHash[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return WriteDictionary[K, V](w, hash)
+}
+
+// ReadFrom replaces the pairs of the hash with those of a snapshot written by WriteTo, or returns an error without
+// changing them
+func (hash *openHash[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateHash[K, V](hash.cmp).(*openHash[K, V])
+	n, err := ReadDictionary[K, V](r, read)
+	if err == nil {
+		*hash = *read
+	}
+	return n, err
+}
+
+func (tree *avlTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return WriteDictionary[K, V](w, tree)
+}
+
+func (tree *avlTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateAVL[K, V](tree.cmp).(*avlTree[K, V])
+	n, err := ReadDictionary[K, V](r, read)
+	if err == nil {
+		*tree = *read
+	}
+	return n, err
+}
+
+func (tree *bTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return WriteDictionary[K, V](w, tree)
+}
+
+func (tree *bTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateBTree[K, V](tree.cmp, tree.degree).(*bTree[K, V])
+	n, err := ReadDictionary[K, V](r, read)
+	if err == nil {
+		*tree = *read
+	}
+	return n, err
+}
+
+func (list *skipList[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return WriteDictionary[K, V](w, list)
+}
+
+// ReadFrom keeps drawing the levels of the nodes from the random source of the skip list
+func (list *skipList[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateSkipList[K, V](list.cmp, 0).(*skipList[K, V])
+	read.rng = list.rng
+	n, err := ReadDictionary[K, V](r, read)
+	if err == nil {
+		*list = *read
+	}
+	return n, err
+}
+
+// WriteTo writes the pairs that have not expired with their expiration, as a snapshot that only an
+// ExpiringDictionary can read
+func (dict *expiringHash[K, V]) WriteTo(w io.Writer) (int64, error) {
+	entries := dict.snapshot()
+	return writePairs(w, _SNAPSHOT_EXPIRING, entries.Length(), func(visit func(K, expiringValue[V]) bool) {
+		entries.Iterate(func(entry *expiringEntry[K, V]) bool {
+			return visit(entry.key, expiringValue[V]{entry.value, entry.expireAt})
+		})
+	})
+}
+
+// ReadFrom replaces the pairs with those of a snapshot written by WriteTo, which keep their expiration. The pairs
+// that expired in the meantime are removed, and passed to onExpire, once the dictionary is used
+func (dict *expiringHash[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	cmp := dict.entries.(*openHash[K, *expiringEntry[K, V]]).cmp
+	read := CreateExpiringHash[K, V](cmp, dict.clock, dict.onExpire).(*expiringHash[K, V])
+	n, err := readPairs(r, _SNAPSHOT_EXPIRING, func(key K, value expiringValue[V]) error {
+		read.save(key, value.Value, value.ExpireAt)
+		return nil
+	})
+	if err == nil {
+		dict.mutex.Lock()
+		dict.entries, dict.expirations = read.entries, read.expirations
+		dict.mutex.Unlock()
+	}
+	return n, err
+}
+
+// WriteTo writes every key with the values associated with it, in the order they were added
+func (multi *multiHash[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return writePairs(w, _SNAPSHOT_MULTI, multi.index.Count(), func(visit func(K, []V) bool) {
+		multi.index.Iterate(func(key K, values TDAList.List[V]) bool {
+			slice := make([]V, 0, values.Length())
+			values.Iterate(func(value V) bool {
+				slice = append(slice, value)
+				return true
+			})
+			return visit(key, slice)
+		})
+	})
+}
+
+func (multi *multiHash[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	keyCmp := multi.index.(*openHash[K, TDAList.List[V]]).cmp
+	read := CreateMultiHash[K, V](keyCmp, multi.valueCmp).(*multiHash[K, V])
+	n, err := readPairs(r, _SNAPSHOT_MULTI, func(key K, values []V) error {
+		for _, value := range values {
+			read.Add(key, value)
+		}
+		return nil
+	})
+	if err == nil {
+		*multi = *read
+	}
+	return n, err
+}
+
+// WriteTo writes every element with its number of occurrences
+func (bag *hashBag[T]) WriteTo(w io.Writer) (int64, error) {
+	return writePairs(w, _SNAPSHOT_BAG, bag.counts.Count(), bag.counts.Iterate)
+}
+
+func (bag *hashBag[T]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateBag[T](bag.cmp).(*hashBag[T])
+	n, err := readPairs(r, _SNAPSHOT_BAG, func(element T, count int) error {
+		if count <= 0 {
+			return fmt.Errorf("dictionary: the element %v occurs %d times", element, count)
+		}
+		read.Add(element, count)
+		return nil
+	})
+	if err == nil {
+		*bag = *read
+	}
+	return n, err
+}
+
+// WriteTo writes the pairs as WriteDictionary does, so that any dictionary can read them
+func (bimap *biMap[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return writePairs(w, _SNAPSHOT_DICTIONARY, bimap.Count(), bimap.Iterate)
+}
+
+// ReadFrom follows the ConflictPolicy of the BiMap for values that appear with more than one key, returning an
+// error instead of panicking for PanicOnConflict. The pairs are replaced in the hashes shared with the inverse view
+func (bimap *biMap[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateBiMap[K, V](bimap.keyCmp, bimap.valueCmp, bimap.policy).(*biMap[K, V])
+	n, err := readPairs(r, _SNAPSHOT_DICTIONARY, func(key K, value V) error {
+		if read.policy == PanicOnConflict && read.BelongsValue(value) && !read.keyCmp(read.GetByValue(value), key) {
+			return fmt.Errorf("dictionary: the value %v belongs to more than one key", value)
+		}
+		read.Save(key, value)
+		return nil
+	})
+	if err == nil {
+		*bimap.forward.(*openHash[K, V]) = *read.forward.(*openHash[K, V])
+		*bimap.backward.(*openHash[V, K]) = *read.backward.(*openHash[V, K])
+	}
+	return n, err
+}
+
+func (hash *openHash[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(hash)
+}
+
+func (hash *openHash[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, hash)
+}
+
+func (hash *openHash[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(hash)
+}
+
+func (hash *openHash[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, hash)
+}
+
+func (tree *avlTree[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *avlTree[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *avlTree[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *avlTree[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *bTree[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *bTree[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *bTree[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *bTree[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (list *skipList[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(list)
+}
+
+func (list *skipList[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, list)
+}
+
+func (list *skipList[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(list)
+}
+
+func (list *skipList[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, list)
+}
+
+func (dict *expiringHash[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(dict)
+}
+
+func (dict *expiringHash[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, dict)
+}
+
+func (dict *expiringHash[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(dict)
+}
+
+func (dict *expiringHash[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, dict)
+}
+
+func (multi *multiHash[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(multi)
+}
+
+func (multi *multiHash[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, multi)
+}
+
+func (multi *multiHash[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(multi)
+}
+
+func (multi *multiHash[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, multi)
+}
+
+func (bag *hashBag[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(bag)
+}
+
+func (bag *hashBag[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, bag)
+}
+
+func (bag *hashBag[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(bag)
+}
+
+func (bag *hashBag[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, bag)
+}
+
+func (bimap *biMap[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(bimap)
+}
+
+func (bimap *biMap[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, bimap)
+}
+
+func (bimap *biMap[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(bimap)
+}
+
+func (bimap *biMap[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, bimap)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func writePairs[K, V any](w io.Writer, kind string, count int, iterate func(func(K, V) bool)) (int64, error) {
+	writer, err := snapshot.NewWriter(w, kind)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, count, func(visit func(snapshot.Pair[K, V]) bool) {
+		iterate(func(key K, value V) bool {
+			return visit(snapshot.Pair[K, V]{Key: key, Value: value})
+		})
+	})
+	return writer.BytesWritten(), err
+}
+
+// readPairs passes every pair of the snapshot to save, stopping at the first error it returns
+func readPairs[K, V any](r io.Reader, kind string, save func(K, V) error) (int64, error) {
+	reader, err := snapshot.NewReader(r, kind)
+	if err != nil {
+		return 0, err
+	}
+	var saveErr error
+	err = snapshot.Read(reader, func(pair snapshot.Pair[K, V]) {
+		if saveErr == nil {
+			saveErr = save(pair.Key, pair.Value)
+		}
+	})
+	if err == nil {
+		err = saveErr
+	}
+	return reader.BytesRead(), err
+}
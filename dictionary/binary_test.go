@@ -0,0 +1,239 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"adts/snapshot"
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var binaryDictionaries = map[string]func() TDADictionary.Dictionary[string, int]{
+	"Hash": func() TDADictionary.Dictionary[string, int] {
+		return TDADictionary.CreateHash[string, int](stringEquality)
+	},
+	"AVL": func() TDADictionary.Dictionary[string, int] {
+		return TDADictionary.CreateAVL[string, int](strings.Compare)
+	},
+	"BTree": func() TDADictionary.Dictionary[string, int] {
+		return TDADictionary.CreateBTree[string, int](strings.Compare, 3)
+	},
+	"SkipList": func() TDADictionary.Dictionary[string, int] {
+		return TDADictionary.CreateSkipList[string, int](strings.Compare, 48)
+	},
+}
+
+func requireSamePairs(t *testing.T, expected, actual TDADictionary.Dictionary[string, int], msgAndArgs ...any) {
+	require.EqualValues(t, expected.Count(), actual.Count(), msgAndArgs...)
+	expected.Iterate(func(key string, value int) bool {
+		require.True(t, actual.Belongs(key), msgAndArgs...)
+		require.EqualValues(t, value, actual.Get(key), msgAndArgs...)
+		return true
+	})
+}
+
+func mustMarshal(t *testing.T, value any) []byte {
+	data, err := value.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	t.Log("Every dictionary reads back the snapshots of any other, replacing the pairs it had")
+	for name, create := range binaryDictionaries {
+		dict := create()
+		for i := 0; i < 3000; i++ {
+			dict.Save(strings.Repeat("k", i%5)+string(rune('a'+i%26))+strings.Repeat("z", i/26), i)
+		}
+		var buffer bytes.Buffer
+		written, err := dict.(io.WriterTo).WriteTo(&buffer)
+		require.NoError(t, err, name)
+		require.EqualValues(t, buffer.Len(), written, name)
+		data := buffer.Bytes()
+
+		for other, createOther := range binaryDictionaries {
+			copied := createOther()
+			copied.Save("descartado", -1)
+			read, err := copied.(io.ReaderFrom).ReadFrom(bytes.NewReader(data))
+			require.NoError(t, err, "%s into %s", name, other)
+			require.Equal(t, written, read, "%s into %s", name, other)
+			require.False(t, copied.Belongs("descartado"))
+			requireSamePairs(t, dict, copied, "%s into %s", name, other)
+			copied.Save("nuevo", 1)
+			require.EqualValues(t, 1, copied.Get("nuevo"))
+		}
+	}
+}
+
+func TestBinaryMarshalerAndGob(t *testing.T) {
+	t.Log("MarshalBinary and gob encode the same snapshot that WriteTo writes")
+	for name, create := range binaryDictionaries {
+		dict := create()
+		dict.Save("A", 1)
+		dict.Save("B", 2)
+		data, err := dict.(encoding.BinaryMarshaler).MarshalBinary()
+		require.NoError(t, err, name)
+		copied := create()
+		require.NoError(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(data), name)
+		requireSamePairs(t, dict, copied, name)
+
+		var buffer bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buffer).Encode(dict), name)
+		copied = create()
+		require.NoError(t, gob.NewDecoder(&buffer).Decode(copied), name)
+		requireSamePairs(t, dict, copied, name)
+	}
+}
+
+func TestBinaryNonStringKeys(t *testing.T) {
+	t.Log("Keys and values of any type that gob can encode are written")
+	type point struct{ X, Y int }
+	dict := TDADictionary.CreateHash[point, []string](func(a, b point) bool { return a == b })
+	dict.Save(point{1, 2}, []string{"a", "b"})
+	dict.Save(point{0, 0}, nil)
+	data, err := dict.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	copied := TDADictionary.CreateHash[point, []string](func(a, b point) bool { return a == b })
+	require.NoError(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+	require.EqualValues(t, 2, copied.Count())
+	require.Equal(t, []string{"a", "b"}, copied.Get(point{1, 2}))
+	require.True(t, copied.Belongs(point{0, 0}))
+}
+
+func TestBinaryInvalidInputKeepsPairs(t *testing.T) {
+	t.Log("A snapshot that cannot be read returns an error and leaves the dictionary as it was")
+	source := TDADictionary.CreateAVL[string, int](strings.Compare)
+	for i := 0; i < 2000; i++ {
+		source.Save(strings.Repeat("x", i), i)
+	}
+	data, err := source.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	for name, create := range binaryDictionaries {
+		dict := create()
+		dict.Save("A", 1)
+		unmarshaler := dict.(encoding.BinaryUnmarshaler)
+		require.ErrorIs(t, unmarshaler.UnmarshalBinary(data[:len(data)/2]), io.ErrUnexpectedEOF, name)
+		require.ErrorIs(t, unmarshaler.UnmarshalBinary(nil), io.ErrUnexpectedEOF, name)
+		require.ErrorIs(t, unmarshaler.UnmarshalBinary([]byte(`{"A":1}`)), snapshot.ErrFormat, name)
+
+		var other bytes.Buffer
+		bag := TDADictionary.CreateBag[string](stringEquality)
+		_, err := bag.(io.WriterTo).WriteTo(&other)
+		require.NoError(t, err)
+		require.ErrorIs(t, unmarshaler.UnmarshalBinary(other.Bytes()), snapshot.ErrKind, name)
+
+		require.EqualValues(t, 1, dict.Count(), name)
+		require.EqualValues(t, 1, dict.Get("A"), name)
+	}
+}
+
+func TestBinaryExpiringHashKeepsExpirations(t *testing.T) {
+	t.Log("The pairs of an ExpiringDictionary keep their expiration in the snapshot")
+	clock := newFakeClock()
+	var expired []string
+	dict := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	dict.Save("A", 1)
+	dict.SaveWithTTL("B", 2, time.Minute)
+	dict.SaveWithTTL("C", 3, time.Hour)
+	dict.SaveWithTTL("D", 4, time.Second)
+	clock.Advance(2 * time.Second)
+	data, err := dict.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	copied := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, func(key string, _ int) {
+		expired = append(expired, key)
+	})
+	copied.Save("Z", 0)
+	require.NoError(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+	require.EqualValues(t, 3, copied.Count())
+	require.False(t, copied.Belongs("Z"))
+
+	clock.Advance(2 * time.Minute)
+	require.EqualValues(t, 2, copied.Count())
+	require.Equal(t, []string{"B"}, expired)
+	clock.Advance(time.Hour)
+	require.EqualValues(t, 1, copied.Count())
+	require.EqualValues(t, 1, copied.Get("A"))
+
+	_, err = copied.(io.ReaderFrom).ReadFrom(bytes.NewReader(mustMarshal(t, TDADictionary.CreateHash[string, int](stringEquality))))
+	require.ErrorIs(t, err, snapshot.ErrKind, "A plain dictionary has no expirations")
+}
+
+func TestBinaryMultiHashAndBag(t *testing.T) {
+	t.Log("MultiDictionaries keep the order of the values of every key, and bags the occurrences of every element")
+	multi := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	multi.Add("A", 3)
+	multi.Add("A", 1)
+	multi.Add("A", 3)
+	multi.Add("B", 2)
+	copied := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	copied.Add("Z", 0)
+	require.NoError(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(mustMarshal(t, multi)))
+	require.EqualValues(t, 4, copied.Count())
+	require.False(t, copied.Belongs("Z"))
+	require.Equal(t, []int{3, 1, 3}, listToSlice(copied.GetAll("A")))
+	copied.Add("B", 5)
+	require.Equal(t, []int{2, 5}, listToSlice(copied.GetAll("B")))
+
+	bag := TDADictionary.CreateBag[int](intEquality)
+	bag.Add(7, 3)
+	bag.Add(5, 1)
+	copiedBag := TDADictionary.CreateBag[int](intEquality)
+	copiedBag.Add(1, 10)
+	require.NoError(t, copiedBag.(encoding.BinaryUnmarshaler).UnmarshalBinary(mustMarshal(t, bag)))
+	require.EqualValues(t, 4, copiedBag.Total())
+	require.EqualValues(t, 3, copiedBag.Count(7))
+	require.EqualValues(t, 0, copiedBag.Count(1))
+}
+
+func TestBinaryBiMap(t *testing.T) {
+	t.Log("A BiMap reads any dictionary snapshot following its ConflictPolicy, and its inverse view sees the pairs")
+	bimap := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.PanicOnConflict)
+	bimap.Save("Bruno", 1)
+	bimap.Save("Abril", 2)
+	hash := TDADictionary.CreateHash[string, int](stringEquality)
+	require.NoError(t, hash.(encoding.BinaryUnmarshaler).UnmarshalBinary(mustMarshal(t, bimap)))
+	require.EqualValues(t, 2, hash.Get("Abril"))
+
+	copied := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.PanicOnConflict)
+	inverse := copied.Inverse()
+	copied.Save("Rocco", 9)
+	require.NoError(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(mustMarshal(t, hash)))
+	require.EqualValues(t, 2, inverse.Count())
+	require.EqualValues(t, "Abril", inverse.GetByKey(2))
+	require.False(t, inverse.BelongsKey(9))
+
+	conflicting := TDADictionary.CreateAVL[string, int](strings.Compare)
+	conflicting.Save("Bruno", 1)
+	conflicting.Save("Rocco", 1)
+	data := mustMarshal(t, conflicting)
+	require.ErrorContains(t, copied.(encoding.BinaryUnmarshaler).UnmarshalBinary(data), "more than one key")
+	require.EqualValues(t, "Bruno", copied.GetByValue(1))
+
+	overwriting := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.OverwriteOnConflict)
+	require.NoError(t, overwriting.(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+	require.EqualValues(t, 1, overwriting.Count())
+	require.EqualValues(t, "Rocco", overwriting.GetByValue(1))
+}
+
+func BenchmarkBinaryHash(b *testing.B) {
+	dict := TDADictionary.CreateHash[int, int](intEquality)
+	for i := 0; i < 100000; i++ {
+		dict.Save(i, i)
+	}
+	writer := dict.(io.WriterTo)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var buffer bytes.Buffer
+		writer.WriteTo(&buffer)
+		TDADictionary.CreateHash[int, int](intEquality).(io.ReaderFrom).ReadFrom(&buffer)
+	}
+}
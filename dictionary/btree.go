@@ -0,0 +1,512 @@
+package dictionary
+
+import (
+	TDAStack "adts/stack"
+	"sort"
+)
+
+const (
+	_PANIC_MESSAGE_DEGREE   = "The degree must be at least 2"
+	_PANIC_MESSAGE_UNSORTED = "The keys must be in increasing order"
+)
+
+// bTreeNode holds between degree-1 and 2*degree-1 sorted keys, except for the root, which may hold fewer. An
+// internal node has one child more than keys, children[i] holding the keys between keys[i-1] and keys[i]
+type bTreeNode[K, V any] struct {
+	keys     []K
+	values   []V
+	children []*bTreeNode[K, V]
+}
+
+type bTree[K, V any] struct {
+	root   *bTreeNode[K, V]
+	degree int
+	count  int
+	cmp    func(K, K) int
+}
+
+// bTreePosition is a node in the iterator stack, together with the position of its next key to visit
+type bTreePosition[K, V any] struct {
+	node *bTreeNode[K, V]
+	pos  int
+}
+
+type iterBTree[K, V any] struct {
+	tree  *bTree[K, V]
+	stack TDAStack.Stack[bTreePosition[K, V]]
+	to    *K
+}
+
+// CreateBTree creates an ordered dictionary backed by a B-tree of the given minimum degree, where cmp compares the
+// keys as in CreateAVL. Every node but the root holds between degree-1 and 2*degree-1 keys, so larger degrees mean
+// fewer and wider nodes. If degree is less than 2, it panics with the message 'The degree must be at least 2'
+func CreateBTree[K, V any](cmp func(K, K) int, degree int) OrderedDictionary[K, V] {
+	if degree < 2 {
+		panic(_PANIC_MESSAGE_DEGREE)
+	}
+	return &bTree[K, V]{root: &bTreeNode[K, V]{}, degree: degree, cmp: cmp}
+}
+
+// CreateBTreeFromSorted works as CreateBTree, but loads every pair traversed by the iterator, whose keys must be in
+// strictly increasing order, building the tree bottom up with its nodes almost full. If the keys are not in
+// order, it panics with the message 'The keys must be in increasing order'
+func CreateBTreeFromSorted[K, V any](cmp func(K, K) int, degree int, pairs DictionaryIterator[K, V]) OrderedDictionary[K, V] {
+	tree := CreateBTree[K, V](cmp, degree).(*bTree[K, V])
+
+	var keys []K
+	var values []V
+	for ; pairs.HasNext(); pairs.Next() {
+		key, value := pairs.Current()
+		if len(keys) > 0 && cmp(keys[len(keys)-1], key) >= 0 {
+			panic(_PANIC_MESSAGE_UNSORTED)
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	height := 0
+	for len(keys) > tree.maxKeys(height) {
+		height++
+	}
+	tree.root = tree.build(keys, values, height)
+	tree.count = len(keys)
+	return tree
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (tree *bTree[K, V]) Save(key K, value V) {
+	if len(tree.root.keys) == 2*tree.degree-1 {
+		tree.root = &bTreeNode[K, V]{children: []*bTreeNode[K, V]{tree.root}}
+		tree.splitChild(tree.root, 0)
+	}
+
+	node := tree.root
+	for {
+		pos, found := tree.search(node, key)
+		if found {
+			node.values[pos] = value
+			return
+		}
+		if node.isLeaf() {
+			node.insertAt(pos, key, value)
+			tree.count++
+			return
+		}
+
+		if len(node.children[pos].keys) == 2*tree.degree-1 {
+			tree.splitChild(node, pos)
+			continue
+		}
+		node = node.children[pos]
+	}
+}
+
+func (tree *bTree[K, V]) Belongs(key K) bool {
+	node, _ := tree.find(key)
+	return node != nil
+}
+
+func (tree *bTree[K, V]) Get(key K) V {
+	node, pos := tree.find(key)
+	if node == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return node.values[pos]
+}
+
+func (tree *bTree[K, V]) Delete(key K) V {
+	// Deleting restructures the nodes on the way down, so a missing key is detected before touching anything
+	if !tree.Belongs(key) {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+
+	value := tree.remove(tree.root, key)
+	if len(tree.root.keys) == 0 && !tree.root.isLeaf() {
+		tree.root = tree.root.children[0]
+	}
+	tree.count--
+	return value
+}
+
+func (tree *bTree[K, V]) Count() int {
+	return tree.count
+}
+
+func (tree *bTree[K, V]) Iterate(visit func(key K, value V) bool) {
+	tree.IterateRange(nil, nil, visit)
+}
+
+func (tree *bTree[K, V]) Iterator() DictionaryIterator[K, V] {
+	return tree.IteratorRange(nil, nil)
+}
+
+func (tree *bTree[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	tree.iterateRange(tree.root, from, to, visit)
+}
+
+func (tree *bTree[K, V]) IteratorRange(from *K, to *K) DictionaryIterator[K, V] {
+	iter := &iterBTree[K, V]{tree: tree, stack: TDAStack.NewDynamicStack[bTreePosition[K, V]](), to: to}
+	iter.pushLeftBranch(tree.root, from)
+	return iter
+}
+
+func (tree *bTree[K, V]) Floor(key K) (K, V, bool) {
+	var candidate *bTreeNode[K, V]
+	candidatePos := 0
+	for node := tree.root; node != nil; {
+		pos, found := tree.search(node, key)
+		if found {
+			return node.keys[pos], node.values[pos], true
+		}
+		if pos > 0 {
+			candidate, candidatePos = node, pos-1
+		}
+		node = node.child(pos)
+	}
+	return candidate.pair(candidatePos)
+}
+
+func (tree *bTree[K, V]) Ceiling(key K) (K, V, bool) {
+	var candidate *bTreeNode[K, V]
+	candidatePos := 0
+	for node := tree.root; node != nil; {
+		pos, found := tree.search(node, key)
+		if found {
+			return node.keys[pos], node.values[pos], true
+		}
+		if pos < len(node.keys) {
+			candidate, candidatePos = node, pos
+		}
+		node = node.child(pos)
+	}
+	return candidate.pair(candidatePos)
+}
+
+func (tree *bTree[K, V]) Min() (K, V) {
+	if tree.count == 0 {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	node := tree.root
+	for !node.isLeaf() {
+		node = node.children[0]
+	}
+	return node.keys[0], node.values[0]
+}
+
+func (tree *bTree[K, V]) Max() (K, V) {
+	if tree.count == 0 {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	node := tree.root
+	for !node.isLeaf() {
+		node = node.children[len(node.children)-1]
+	}
+	last := len(node.keys) - 1
+	return node.keys[last], node.values[last]
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterBTree[K, V]) HasNext() bool {
+	if iter.stack.IsEmpty() {
+		return false
+	}
+	top := iter.stack.Top()
+	return iter.to == nil || iter.tree.cmp(top.node.keys[top.pos], *iter.to) <= 0
+}
+
+func (iter *iterBTree[K, V]) Current() (K, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	top := iter.stack.Top()
+	return top.node.keys[top.pos], top.node.values[top.pos]
+}
+
+func (iter *iterBTree[K, V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	top := iter.stack.Pop()
+	if top.pos+1 < len(top.node.keys) {
+		iter.stack.Push(bTreePosition[K, V]{top.node, top.pos + 1})
+	}
+	if !top.node.isLeaf() {
+		iter.pushLeftBranch(top.node.children[top.pos+1], nil)
+	}
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Dictionary functions
+
+// search returns the position of the first key of the node that is not less than key, and whether it is key
+func (tree *bTree[K, V]) search(node *bTreeNode[K, V], key K) (int, bool) {
+	pos := sort.Search(len(node.keys), func(i int) bool {
+		return tree.cmp(node.keys[i], key) >= 0
+	})
+	return pos, pos < len(node.keys) && tree.cmp(node.keys[pos], key) == 0
+}
+
+// find returns the node that holds key and its position, or nil if the key does not belong to the tree
+func (tree *bTree[K, V]) find(key K) (*bTreeNode[K, V], int) {
+	for node := tree.root; node != nil; {
+		pos, found := tree.search(node, key)
+		if found {
+			return node, pos
+		}
+		node = node.child(pos)
+	}
+	return nil, 0
+}
+
+// remove deletes key, which must belong to the subtree, making sure beforehand that every node it descends to
+// has at least degree keys, so that it can lose one without going under the minimum
+func (tree *bTree[K, V]) remove(node *bTreeNode[K, V], key K) V {
+	pos, found := tree.search(node, key)
+	if node.isLeaf() {
+		value := node.values[pos]
+		node.removeAt(pos)
+		return value
+	}
+
+	if found {
+		value := node.values[pos]
+		switch {
+		case len(node.children[pos].keys) >= tree.degree:
+			// The key takes the place of its predecessor, which is removed from the left subtree
+			predecessor := node.children[pos]
+			for !predecessor.isLeaf() {
+				predecessor = predecessor.children[len(predecessor.children)-1]
+			}
+			last := len(predecessor.keys) - 1
+			node.keys[pos], node.values[pos] = predecessor.keys[last], predecessor.values[last]
+			tree.remove(node.children[pos], node.keys[pos])
+		case len(node.children[pos+1].keys) >= tree.degree:
+			// The key takes the place of its successor, which is removed from the right subtree
+			successor := node.children[pos+1]
+			for !successor.isLeaf() {
+				successor = successor.children[0]
+			}
+			node.keys[pos], node.values[pos] = successor.keys[0], successor.values[0]
+			tree.remove(node.children[pos+1], node.keys[pos])
+		default:
+			tree.mergeChildren(node, pos)
+			tree.remove(node.children[pos], key)
+		}
+		return value
+	}
+
+	if len(node.children[pos].keys) < tree.degree {
+		pos = tree.fillChild(node, pos)
+	}
+	return tree.remove(node.children[pos], key)
+}
+
+func (tree *bTree[K, V]) iterateRange(node *bTreeNode[K, V], from *K, to *K, visit func(K, V) bool) bool {
+	pos := 0
+	if from != nil {
+		pos, _ = tree.search(node, *from)
+	}
+
+	for ; pos <= len(node.keys); pos++ {
+		if !node.isLeaf() && !tree.iterateRange(node.children[pos], from, to, visit) {
+			return false
+		}
+		if pos == len(node.keys) {
+			break
+		}
+		if to != nil && tree.cmp(node.keys[pos], *to) > 0 {
+			return false
+		}
+		if !visit(node.keys[pos], node.values[pos]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Balancing functions
+
+// splitChild splits the full child in position pos of the node, moving its middle key up to the node
+func (tree *bTree[K, V]) splitChild(node *bTreeNode[K, V], pos int) {
+	child := node.children[pos]
+	middle := tree.degree - 1
+
+	sibling := tree.newNode(child.keys[middle+1:], child.values[middle+1:])
+	if !child.isLeaf() {
+		sibling.children = append(make([]*bTreeNode[K, V], 0, 2*tree.degree), child.children[middle+1:]...)
+		clear(child.children[middle+1:])
+		child.children = child.children[:middle+1]
+	}
+
+	node.insertAt(pos, child.keys[middle], child.values[middle])
+	node.children = append(node.children, nil)
+	copy(node.children[pos+2:], node.children[pos+1:])
+	node.children[pos+1] = sibling
+
+	clear(child.keys[middle:])
+	clear(child.values[middle:])
+	child.keys, child.values = child.keys[:middle], child.values[:middle]
+}
+
+// fillChild gives the child in position pos of the node, which has degree-1 keys, one more key, either rotating it
+// from a sibling that can spare it or merging the child with a sibling. It returns the new position of the child
+func (tree *bTree[K, V]) fillChild(node *bTreeNode[K, V], pos int) int {
+	child := node.children[pos]
+	switch {
+	case pos > 0 && len(node.children[pos-1].keys) >= tree.degree:
+		left := node.children[pos-1]
+		last := len(left.keys) - 1
+		child.insertAt(0, node.keys[pos-1], node.values[pos-1])
+		node.keys[pos-1], node.values[pos-1] = left.keys[last], left.values[last]
+		if !left.isLeaf() {
+			child.children = append(child.children, nil)
+			copy(child.children[1:], child.children)
+			child.children[0] = left.children[last+1]
+			left.children[last+1] = nil
+			left.children = left.children[:last+1]
+		}
+		left.removeAt(last)
+	case pos < len(node.keys) && len(node.children[pos+1].keys) >= tree.degree:
+		right := node.children[pos+1]
+		child.insertAt(len(child.keys), node.keys[pos], node.values[pos])
+		node.keys[pos], node.values[pos] = right.keys[0], right.values[0]
+		if !right.isLeaf() {
+			child.children = append(child.children, right.children[0])
+			copy(right.children, right.children[1:])
+			right.children[len(right.children)-1] = nil
+			right.children = right.children[:len(right.children)-1]
+		}
+		right.removeAt(0)
+	case pos < len(node.keys):
+		tree.mergeChildren(node, pos)
+	default:
+		tree.mergeChildren(node, pos-1)
+		pos--
+	}
+	return pos
+}
+
+// mergeChildren joins the children in positions pos and pos+1 of the node, together with the key between them,
+// into the child in position pos
+func (tree *bTree[K, V]) mergeChildren(node *bTreeNode[K, V], pos int) {
+	left, right := node.children[pos], node.children[pos+1]
+	left.keys = append(append(left.keys, node.keys[pos]), right.keys...)
+	left.values = append(append(left.values, node.values[pos]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	node.removeAt(pos)
+	copy(node.children[pos+1:], node.children[pos+2:])
+	node.children[len(node.children)-1] = nil
+	node.children = node.children[:len(node.children)-1]
+}
+
+// Bulk loading functions
+
+// maxKeys returns how many keys fit in a subtree of the given height, a leaf having height 0
+func (tree *bTree[K, V]) maxKeys(height int) int {
+	capacity := 1
+	for i := 0; i <= height; i++ {
+		capacity *= 2 * tree.degree
+	}
+	return capacity - 1
+}
+
+// build creates a subtree of the given height with the sorted keys, using as few children per node as possible
+// and spreading the keys evenly among them, which keeps every node above the minimum
+func (tree *bTree[K, V]) build(keys []K, values []V, height int) *bTreeNode[K, V] {
+	if height == 0 {
+		return tree.newNode(keys, values)
+	}
+
+	childCapacity := tree.maxKeys(height - 1)
+	children := max(2, (len(keys)+childCapacity+1)/(childCapacity+1))
+	perChild, extra := (len(keys)-children+1)/children, (len(keys)-children+1)%children
+
+	node := tree.newNode(nil, nil)
+	node.children = make([]*bTreeNode[K, V], 0, 2*tree.degree)
+	start := 0
+	for i := 0; i < children; i++ {
+		end := start + perChild
+		if i < extra {
+			end++
+		}
+		node.children = append(node.children, tree.build(keys[start:end], values[start:end], height-1))
+		if i < children-1 {
+			node.keys = append(node.keys, keys[end])
+			node.values = append(node.values, values[end])
+		}
+		start = end + 1
+	}
+	return node
+}
+
+// Node functions
+
+// newNode creates a node with a copy of the keys and values, with room for as many keys as a node can hold
+func (tree *bTree[K, V]) newNode(keys []K, values []V) *bTreeNode[K, V] {
+	return &bTreeNode[K, V]{
+		keys:   append(make([]K, 0, 2*tree.degree-1), keys...),
+		values: append(make([]V, 0, 2*tree.degree-1), values...),
+	}
+}
+
+func (node *bTreeNode[K, V]) isLeaf() bool {
+	return node.children == nil
+}
+
+// child returns the child in position pos, or nil if the node is a leaf
+func (node *bTreeNode[K, V]) child(pos int) *bTreeNode[K, V] {
+	if node.isLeaf() {
+		return nil
+	}
+	return node.children[pos]
+}
+
+func (node *bTreeNode[K, V]) insertAt(pos int, key K, value V) {
+	var zeroKey K
+	var zeroValue V
+	node.keys = append(node.keys, zeroKey)
+	node.values = append(node.values, zeroValue)
+	copy(node.keys[pos+1:], node.keys[pos:])
+	copy(node.values[pos+1:], node.values[pos:])
+	node.keys[pos], node.values[pos] = key, value
+}
+
+func (node *bTreeNode[K, V]) removeAt(pos int) {
+	last := len(node.keys) - 1
+	copy(node.keys[pos:], node.keys[pos+1:])
+	copy(node.values[pos:], node.values[pos+1:])
+	clear(node.keys[last:])
+	clear(node.values[last:])
+	node.keys, node.values = node.keys[:last], node.values[:last]
+}
+
+// pair returns the key and value in position pos and true, or zero values and false if the node is nil
+func (node *bTreeNode[K, V]) pair(pos int) (K, V, bool) {
+	if node == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return node.keys[pos], node.values[pos], true
+}
+
+// External iterator functions
+
+// pushLeftBranch stacks the path from the node to the first key that is not before the lower bound, skipping
+// the nodes whose keys are all before it
+func (iter *iterBTree[K, V]) pushLeftBranch(node *bTreeNode[K, V], from *K) {
+	for node != nil {
+		pos := 0
+		if from != nil {
+			pos, _ = iter.tree.search(node, *from)
+		}
+		if pos < len(node.keys) {
+			iter.stack.Push(bTreePosition[K, V]{node, pos})
+		}
+		node = node.child(pos)
+	}
+}
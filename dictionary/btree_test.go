@@ -0,0 +1,231 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var BTREE_DEGREES = []int{2, 3, 16}
+
+// sortedPairs returns an iterator over n pairs with keys 0, 2, 4, ... and values equal to their keys
+func sortedPairs(n int) TDADictionary.DictionaryIterator[int, int] {
+	avl := TDADictionary.CreateAVL[int, int](intCompare)
+	for i := 0; i < n; i++ {
+		avl.Save(2*i, 2*i)
+	}
+	return avl.Iterator()
+}
+
+func TestBTreeInvalidDegree(t *testing.T) {
+	t.Log("A B-tree needs a degree of at least 2")
+	require.PanicsWithValue(t, "The degree must be at least 2", func() { TDADictionary.CreateBTree[int, int](intCompare, 1) })
+}
+
+func TestBTreeEmptyDictionary(t *testing.T) {
+	t.Log("Check that an empty B-tree has no keys")
+	dict := TDADictionary.CreateBTree[string, string](strings.Compare, 2)
+	require.EqualValues(t, 0, dict.Count())
+	require.False(t, dict.Belongs("A"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("A") })
+	require.PanicsWithValue(t, "The dictionary is empty", func() { dict.Min() })
+	require.PanicsWithValue(t, "The dictionary is empty", func() { dict.Max() })
+	_, _, found := dict.Floor("A")
+	require.False(t, found)
+
+	iter := dict.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Current() })
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Next() })
+}
+
+func TestBTreeSaveReplaceAndDelete(t *testing.T) {
+	t.Log("Save, replace and delete some keys, checking that the B-tree behaves appropriately")
+	dict := TDADictionary.CreateBTree[string, string](strings.Compare, 2)
+	dict.Save("Cat", "meow")
+	dict.Save("Dog", "woof")
+	dict.Save("Cow", "moo")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "woof", dict.Get("Dog"))
+
+	dict.Save("Dog", "bark")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "bark", dict.Get("Dog"))
+
+	require.EqualValues(t, "meow", dict.Delete("Cat"))
+	require.False(t, dict.Belongs("Cat"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("Cat") })
+	require.EqualValues(t, 2, dict.Count())
+	require.True(t, dict.Belongs("Cow"))
+	require.True(t, dict.Belongs("Dog"))
+}
+
+func TestBTreeBulkLoad(t *testing.T) {
+	t.Log("Loading sorted pairs builds a tree with all of them, which keeps working after the load")
+	for _, degree := range BTREE_DEGREES {
+		for _, n := range []int{0, 1, 7, 100, 1000} {
+			dict := TDADictionary.CreateBTreeFromSorted(intCompare, degree, sortedPairs(n))
+			require.EqualValues(t, n, dict.Count())
+
+			expected := 0
+			for iter := dict.Iterator(); iter.HasNext(); iter.Next() {
+				key, value := iter.Current()
+				require.EqualValues(t, expected, key)
+				require.EqualValues(t, expected, value)
+				expected += 2
+			}
+			require.EqualValues(t, 2*n, expected)
+
+			for i := 0; i < n; i++ {
+				dict.Save(2*i+1, 2*i+1)
+			}
+			for i := 0; i < 2*n; i += 3 {
+				require.EqualValues(t, i, dict.Delete(i))
+			}
+			require.EqualValues(t, 2*n-(2*n+2)/3, dict.Count())
+		}
+	}
+
+	unsorted := TDADictionary.CreateHash[int, int](intEquality)
+	for i := 0; i < 10; i++ {
+		unsorted.Save(i, i)
+	}
+	require.PanicsWithValue(t, "The keys must be in increasing order", func() {
+		TDADictionary.CreateBTreeFromSorted(intCompare, 2, unsorted.Iterator())
+	})
+}
+
+func TestBTreeRanges(t *testing.T) {
+	t.Log("Range scans only visit the keys between both bounds, which may be open")
+	dict := TDADictionary.CreateBTree[int, int](intCompare, 2)
+	for i := 0; i < 100; i += 5 {
+		dict.Save(i, i)
+	}
+
+	collect := func(from, to *int) ([]int, []int) {
+		var internal, external []int
+		dict.IterateRange(from, to, func(key int, _ int) bool {
+			internal = append(internal, key)
+			return true
+		})
+		for iter := dict.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+			key, _ := iter.Current()
+			external = append(external, key)
+		}
+		return internal, external
+	}
+
+	from, to := 12, 31
+	internal, external := collect(&from, &to)
+	require.Equal(t, []int{15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	internal, external = collect(nil, &to)
+	require.Equal(t, []int{0, 5, 10, 15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	from = 85
+	internal, external = collect(&from, nil)
+	require.Equal(t, []int{85, 90, 95}, internal)
+	require.Equal(t, internal, external)
+
+	from, to = 41, 44
+	internal, external = collect(&from, &to)
+	require.Empty(t, internal)
+	require.Empty(t, external)
+
+	visited := 0
+	dict.IterateRange(nil, nil, func(key int, _ int) bool {
+		visited++
+		return key < 20
+	})
+	require.EqualValues(t, 5, visited, "The internal iterator stops as soon as visit returns false")
+}
+
+func TestBTreeRandomOperations(t *testing.T) {
+	t.Log("Random operations, that split, rotate and merge nodes, are checked against the AVL for several degrees")
+	for _, degree := range BTREE_DEGREES {
+		rng := rand.New(rand.NewSource(int64(degree)))
+		dict := TDADictionary.CreateBTree[int, int](intCompare, degree)
+		avl := TDADictionary.CreateAVL[int, int](intCompare)
+
+		for i := 0; i < 20000; i++ {
+			key := rng.Intn(2000)
+			if avl.Belongs(key) && rng.Intn(2) == 0 {
+				require.EqualValues(t, avl.Delete(key), dict.Delete(key))
+			} else {
+				dict.Save(key, i)
+				avl.Save(key, i)
+			}
+
+			probe := rng.Intn(2100) - 50
+			expectedKey, expectedValue, expectedFound := avl.Floor(probe)
+			key, value, found := dict.Floor(probe)
+			require.Equal(t, []any{expectedKey, expectedValue, expectedFound}, []any{key, value, found})
+			expectedKey, expectedValue, expectedFound = avl.Ceiling(probe)
+			key, value, found = dict.Ceiling(probe)
+			require.Equal(t, []any{expectedKey, expectedValue, expectedFound}, []any{key, value, found})
+		}
+
+		require.EqualValues(t, avl.Count(), dict.Count())
+		minKey, _ := avl.Min()
+		key, _ := dict.Min()
+		require.EqualValues(t, minKey, key)
+		maxKey, _ := avl.Max()
+		key, _ = dict.Max()
+		require.EqualValues(t, maxKey, key)
+
+		iter := avl.Iterator()
+		dict.Iterate(func(key int, value int) bool {
+			expectedKey, expectedValue := iter.Current()
+			require.EqualValues(t, expectedKey, key)
+			require.EqualValues(t, expectedValue, value)
+			iter.Next()
+			return true
+		})
+		require.False(t, iter.HasNext())
+	}
+}
+
+func BenchmarkBTreeVersusHash(b *testing.B) {
+	b.Log("Saves, gets and deletes different amounts of elements in a B-tree of degree 32 and in a hash")
+	constructors := map[string]func() TDADictionary.Dictionary[string, int]{
+		"Hash": func() TDADictionary.Dictionary[string, int] {
+			return TDADictionary.CreateHash[string, int](stringEquality)
+		},
+		"BTree": func() TDADictionary.Dictionary[string, int] {
+			return TDADictionary.CreateBTree[string, int](strings.Compare, 32)
+		},
+	}
+
+	for _, name := range []string{"Hash", "BTree"} {
+		for _, n := range VOLUME_SIZES {
+			b.Run(fmt.Sprintf("%s %d elements", name, n), func(b *testing.B) {
+				keys := make([]string, n)
+				for j := range keys {
+					keys[j] = fmt.Sprintf("%08d", j)
+				}
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					dict := constructors[name]()
+					for j, key := range keys {
+						dict.Save(key, j)
+					}
+					for j, key := range keys {
+						require.EqualValues(b, j, dict.Get(key))
+					}
+					for j, key := range keys {
+						require.EqualValues(b, j, dict.Delete(key))
+					}
+					require.EqualValues(b, 0, dict.Count())
+				}
+			})
+		}
+	}
+}
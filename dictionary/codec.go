@@ -0,0 +1,75 @@
+package dictionary
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+type gobCodec[K, V any] struct{}
+
+type jsonCodec[K, V any] struct{}
+
+// GobCodec returns a Codec that encodes keys and values with encoding/gob, each of them on its own. As every record
+// must be decoded without the others, each key and value carries the description of its type, which for structs is
+// often larger than the data itself, so JSONCodec or a Codec of its own may give a much smaller log
+func GobCodec[K, V any]() Codec[K, V] {
+	return gobCodec[K, V]{}
+}
+
+// JSONCodec returns a Codec that encodes keys and values with encoding/json, which keeps the log readable
+func JSONCodec[K, V any]() Codec[K, V] {
+	return jsonCodec[K, V]{}
+}
+
+// -------------------- CODEC PRIMITIVES --------------------
+
+func (gobCodec[K, V]) EncodeKey(key K) ([]byte, error) {
+	return gobEncode(key)
+}
+
+func (gobCodec[K, V]) DecodeKey(data []byte) (K, error) {
+	return gobDecode[K](data)
+}
+
+func (gobCodec[K, V]) EncodeValue(value V) ([]byte, error) {
+	return gobEncode(value)
+}
+
+func (gobCodec[K, V]) DecodeValue(data []byte) (V, error) {
+	return gobDecode[V](data)
+}
+
+func (jsonCodec[K, V]) EncodeKey(key K) ([]byte, error) {
+	return json.Marshal(key)
+}
+
+func (jsonCodec[K, V]) DecodeKey(data []byte) (K, error) {
+	var key K
+	err := json.Unmarshal(data, &key)
+	return key, err
+}
+
+func (jsonCodec[K, V]) EncodeValue(value V) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (jsonCodec[K, V]) DecodeValue(data []byte) (V, error) {
+	var value V
+	err := json.Unmarshal(data, &value)
+	return value, err
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func gobEncode[T any](element T) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(&element)
+	return buffer.Bytes(), err
+}
+
+func gobDecode[T any](data []byte) (T, error) {
+	var element T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&element)
+	return element, err
+}
@@ -1,5 +1,11 @@
 package dictionary
 
+import (
+	TDAList "adts/list"
+	"io"
+	"time"
+)
+
 type Dictionary[K any, V any] interface {
 
 	// Save stores the key-value pair in the Dictionary. If the key already exists, it updates the associated value
@@ -26,6 +32,227 @@ type Dictionary[K any, V any] interface {
 	Iterator() DictionaryIterator[K, V]
 }
 
+type OrderedDictionary[K any, V any] interface {
+	Dictionary[K, V]
+
+	// IterateRange internally iterates, in order, through the elements whose keys are between from and to (both
+	// included), applying the passed function to them. A nil bound leaves that side of the range open
+	IterateRange(from *K, to *K, visit func(key K, value V) bool)
+
+	// IteratorRange returns a DictionaryIterator that traverses, in order, the elements whose keys are between from
+	// and to (both included). A nil bound leaves that side of the range open
+	IteratorRange(from *K, to *K) DictionaryIterator[K, V]
+
+	// Floor returns the greatest key that is less than or equal to key, its value and true. If there is no such key,
+	// the third result is false
+	Floor(key K) (K, V, bool)
+
+	// Ceiling returns the least key that is greater than or equal to key, its value and true. If there is no such
+	// key, the third result is false
+	Ceiling(key K) (K, V, bool)
+
+	// Min returns the least key and its value. If the dictionary is empty, it must panic with the message
+	// 'The dictionary is empty'
+	Min() (K, V)
+
+	// Max returns the greatest key and its value. If the dictionary is empty, it must panic with the message
+	// 'The dictionary is empty'
+	Max() (K, V)
+}
+
+type ExpiringDictionary[K any, V any] interface {
+	Dictionary[K, V]
+
+	// SaveWithTTL stores the key-value pair like Save, but the pair expires once ttl has elapsed. Expired pairs
+	// behave as if they had been deleted. If ttl is not positive, it must panic with the message 'The TTL must be positive'
+	SaveWithTTL(key K, value V, ttl time.Duration)
+
+	// RemoveExpired removes every expired pair, returning how many there were
+	RemoveExpired() int
+
+	// StartJanitor starts a goroutine that calls RemoveExpired every interval, until StopJanitor is called. If the
+	// interval is not positive, it must panic with the message 'The interval must be positive', and if the janitor
+	// is already running, with 'The janitor is already running'
+	StartJanitor(interval time.Duration)
+
+	// StopJanitor stops the janitor goroutine, waiting for it to finish. It does nothing if the janitor is not running
+	StopJanitor()
+}
+
+type MultiDictionary[K any, V any] interface {
+
+	// Add associates one more value to the key, keeping the values that were already associated with it
+	Add(key K, value V)
+
+	// Belongs determines whether the key has at least one value associated in the dictionary or not
+	Belongs(key K) bool
+
+	// GetAll returns a new list with the values associated with the key, in the order they were added. If the key
+	// does not belong to the dictionary, the list is empty
+	GetAll(key K) TDAList.List[V]
+
+	// RemoveValue removes one occurrence of the value from the ones associated with the key. If the key does not
+	// belong to the dictionary, it must panic with the message 'The key does not belong to the dictionary', and if
+	// the value is not associated with it, with 'The value is not associated with the key'
+	RemoveValue(key K, value V)
+
+	// RemoveAll removes the key from the dictionary, returning the list of values that were associated with it.
+	// If the key does not belong to the dictionary, it must panic with the message 'The key does not belong to the dictionary'
+	RemoveAll(key K) TDAList.List[V]
+
+	// CountKey returns the number of values associated with the key
+	CountKey(key K) int
+
+	// Count returns the total number of values in the dictionary, adding up those of every key
+	Count() int
+
+	// Iterate internally iterates through every key-value pair of the dictionary, applying the passed function
+	Iterate(func(key K, value V) bool)
+}
+
+type Bag[T any] interface {
+
+	// Add adds n occurrences of the element to the bag. If n is not positive, it must panic with the message
+	// 'The amount must be positive'
+	Add(element T, n int)
+
+	// Remove removes n occurrences of the element from the bag. If n is not positive, it must panic with the message
+	// 'The amount must be positive', and if the bag has less than n occurrences, with
+	// 'The bag does not have enough occurrences of the element'
+	Remove(element T, n int)
+
+	// Count returns the number of occurrences of the element in the bag, which is 0 if it does not belong to it
+	Count(element T) int
+
+	// Distinct returns the number of different elements in the bag
+	Distinct() int
+
+	// Total returns the number of occurrences in the bag, adding up those of every element
+	Total() int
+
+	// MostCommon returns a list with the k elements that occur the most, from the most to the least frequent. If
+	// the bag has less than k different elements, it returns all of them
+	MostCommon(k int) TDAList.List[BagEntry[T]]
+
+	// Union returns a new bag where each element occurs as many times as in the bag where it occurs the most
+	Union(other Bag[T]) Bag[T]
+
+	// Intersection returns a new bag where each element occurs as many times as in the bag where it occurs the least
+	Intersection(other Bag[T]) Bag[T]
+
+	// Iterate internally iterates through the different elements of the bag, applying the passed function to each
+	// element and its number of occurrences
+	Iterate(func(element T, count int) bool)
+}
+
+// BagEntry is an element of a Bag together with its number of occurrences
+type BagEntry[T any] struct {
+	Element T
+	Count   int
+}
+
+type BiMap[K any, V any] interface {
+
+	// Save associates the key with the value. If the key already exists, its previous value is released. If the value
+	// already belongs to another key, the ConflictPolicy of the BiMap decides: PanicOnConflict panics with the
+	// message 'The value already belongs to another key', and OverwriteOnConflict removes that other key
+	Save(key K, value V)
+
+	// BelongsKey determines whether a key is already in the BiMap or not
+	BelongsKey(key K) bool
+
+	// BelongsValue determines whether a value is already in the BiMap or not
+	BelongsValue(value V) bool
+
+	// GetByKey returns the value associated with a key. If the key does not exist, it must panic with the message
+	// 'The key does not belong to the dictionary'
+	GetByKey(key K) V
+
+	// GetByValue returns the key associated with a value. If the value does not exist, it must panic with the message
+	// 'The value does not belong to the dictionary'
+	GetByValue(value V) K
+
+	// DeleteByKey removes the key and its value, returning the value. If the key does not belong to the BiMap,
+	// it must panic with the message 'The key does not belong to the dictionary'
+	DeleteByKey(key K) V
+
+	// DeleteByValue removes the value and its key, returning the key. If the value does not belong to the BiMap,
+	// it must panic with the message 'The value does not belong to the dictionary'
+	DeleteByValue(value V) K
+
+	// Count returns the number of pairs in the BiMap
+	Count() int
+
+	// Iterate internally iterates through the BiMap, applying the passed function to all pairs
+	Iterate(func(key K, value V) bool)
+
+	// Inverse returns a view of the BiMap with keys and values swapped. It shares the pairs with this BiMap, so
+	// changes made through either of them are seen by both
+	Inverse() BiMap[V, K]
+}
+
+// ConflictPolicy tells a BiMap what to do when saving a value that already belongs to another key
+type ConflictPolicy int
+
+const (
+	PanicOnConflict ConflictPolicy = iota
+	OverwriteOnConflict
+)
+
+type PersistentDictionary[K any, V any] interface {
+	Dictionary[K, V]
+
+	// Compact rewrites the log with a single record for every pair in the dictionary, replacing the old log only
+	// once the new one is safely on disk
+	Compact() error
+
+	// Sync flushes the log to disk, whatever the SyncPolicy of the dictionary, returning Err if there was one
+	Sync() error
+
+	// Err returns the first error that happened writing the log to disk, if any. Once there is one, the changes are
+	// only kept in memory, and Compact, Sync and Close return that error. A pair the Codec cannot encode is not such
+	// an error: Save and Delete must panic with the message 'The pair cannot be encoded' without changing anything
+	Err() error
+
+	// Close syncs and closes the log. Afterwards the dictionary can still be read, but Save and Delete must panic
+	// with the message 'The dictionary is closed'
+	Close() error
+}
+
+// SyncPolicy tells a PersistentDictionary when to flush its log to disk: after every change, every so often or
+// only when the operating system decides to
+type SyncPolicy struct {
+	interval time.Duration
+}
+
+var (
+	SyncAlways = SyncPolicy{0}
+	SyncNever  = SyncPolicy{-1}
+)
+
+// SyncEvery returns the SyncPolicy that flushes the log, if it changed, once every interval, which must be positive
+func SyncEvery(interval time.Duration) SyncPolicy {
+	if interval <= 0 {
+		panic(_PANIC_MESSAGE_INTERVAL)
+	}
+	return SyncPolicy{interval}
+}
+
+// Codec turns the keys and values of a PersistentDictionary into bytes for its log, and back
+type Codec[K any, V any] interface {
+	EncodeKey(key K) ([]byte, error)
+	DecodeKey(data []byte) (K, error)
+	EncodeValue(value V) ([]byte, error)
+	DecodeValue(data []byte) (V, error)
+}
+
+// Dumper is implemented by the dictionaries that can describe how they keep their pairs, for debugging
+type Dumper interface {
+
+	// Dump writes the inner structure of the dictionary, in a format meant to be read by people and not parsed
+	Dump(w io.Writer) error
+}
+
 type DictionaryIterator[K any, V any] interface {
 
 	// HasNext returns whether there are more elements to see. That is, if the current position of the iterator
@@ -3,6 +3,7 @@ package dictionary_test
 import (
 	TDADictionary "adts/dictionary"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,19 @@ func intEquality(a, b int) bool {
 	return a == b
 }
 
+// orderedDictionaries builds every implementation of OrderedDictionary, to check them against the same contract
+var orderedDictionaries = map[string]func() TDADictionary.OrderedDictionary[int, string]{
+	"AVL": func() TDADictionary.OrderedDictionary[int, string] {
+		return TDADictionary.CreateAVL[int, string](intCompare)
+	},
+	"SkipList": func() TDADictionary.OrderedDictionary[int, string] {
+		return TDADictionary.CreateSkipList[int, string](intCompare, 4)
+	},
+	"BTree": func() TDADictionary.OrderedDictionary[int, string] {
+		return TDADictionary.CreateBTree[int, string](intCompare, 2)
+	},
+}
+
 func TestEmptyDictionary(t *testing.T) {
 	t.Log("Check that empty Dictionary has no keys")
 	dict := TDADictionary.CreateHash[string, string](stringEquality)
@@ -301,6 +315,39 @@ func TestRepeatedSaveAndDelete(t *testing.T) {
 	}
 }
 
+func hashBuckets(t *testing.T, dict TDADictionary.Dictionary[int, int]) int {
+	var text strings.Builder
+	require.NoError(t, dict.(TDADictionary.Dumper).Dump(&text))
+	var pairs, buckets int
+	_, err := fmt.Sscanf(text.String(), "hash: %d pairs in %d buckets", &pairs, &buckets)
+	require.NoError(t, err)
+	return buckets
+}
+
+func TestSaveAndDeleteAtResizeBoundary(t *testing.T) {
+	t.Log("Alternating a save and a delete right after the table grows must not resize it on every call, " +
+		"which would make each of them traverse the whole table")
+	dict := TDADictionary.CreateHash[int, int](intEquality)
+	buckets := hashBuckets(t, dict)
+	i := 0
+	for ; hashBuckets(t, dict) == buckets; i++ {
+		dict.Save(i, i)
+	}
+	grown := hashBuckets(t, dict)
+
+	for j := 0; j < 100; j++ {
+		dict.Delete(i - 1)
+		require.Equal(t, grown, hashBuckets(t, dict))
+		dict.Save(i-1, i-1)
+		require.Equal(t, grown, hashBuckets(t, dict))
+	}
+
+	for ; i > 0; i-- {
+		dict.Delete(i - 1)
+	}
+	require.Equal(t, buckets, hashBuckets(t, dict), "The table shrinks back once it is emptied")
+}
+
 func search(key string, keys []string) int {
 	for i, k := range keys {
 		if k == key {
@@ -662,3 +709,29 @@ func TestVolumeIteratorCutoff(t *testing.T) {
 	require.False(t, continuedExecutingWhenItShouldnt,
 		"It should not have continued executing if we found an element that made the iteration cut")
 }
+
+func TestOrderedDictionaryMinAndMax(t *testing.T) {
+	t.Log("Min and Max return the extreme pairs, and panic on an empty dictionary")
+	for name, create := range orderedDictionaries {
+		dict := create()
+		require.PanicsWithValue(t, "The dictionary is empty", func() { dict.Min() }, name)
+		require.PanicsWithValue(t, "The dictionary is empty", func() { dict.Max() }, name)
+
+		for _, key := range []int{40, 10, 50, 30, 20} {
+			dict.Save(key, fmt.Sprint(key))
+		}
+		key, value := dict.Min()
+		require.EqualValues(t, 10, key, name)
+		require.EqualValues(t, "10", value, name)
+		key, value = dict.Max()
+		require.EqualValues(t, 50, key, name)
+		require.EqualValues(t, "50", value, name)
+
+		dict.Delete(50)
+		dict.Delete(10)
+		key, _ = dict.Max()
+		require.EqualValues(t, 40, key, name)
+		key, _ = dict.Min()
+		require.EqualValues(t, 20, key, name)
+	}
+}
@@ -0,0 +1,285 @@
+package dictionary
+
+import (
+	TDAList "adts/list"
+	TDAPriorityQueue "adts/priorityqueue"
+	"sync"
+	"time"
+)
+
+const (
+	_PANIC_MESSAGE_TTL     = "The TTL must be positive"
+	_PANIC_MESSAGE_JANITOR = "The janitor is already running"
+)
+
+type expiringEntry[K, V any] struct {
+	key      K
+	value    V
+	expireAt time.Time
+	handle   TDAPriorityQueue.Handle[expiration[K, V]]
+}
+
+// expiration is the element stored in the heap, so that an entry can be moved to the top by decreasing its time
+type expiration[K, V any] struct {
+	at    time.Time
+	entry *expiringEntry[K, V]
+}
+
+type expiringHash[K, V any] struct {
+	mutex       sync.Mutex
+	entries     Dictionary[K, *expiringEntry[K, V]]
+	expirations TDAPriorityQueue.AddressableQueue[expiration[K, V]]
+	clock       func() time.Time
+	onExpire    func(K, V)
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+type iterExpiringHash[K, V any] struct {
+	snapshot TDAList.ListIterator[*expiringEntry[K, V]]
+}
+
+// CreateExpiringHash creates an ExpiringDictionary backed by a hash, where keys are compared with cmp as in CreateHash.
+// clock tells the current time, and defaults to time.Now when nil. onExpire, if not nil, is called with every pair
+// removed because it expired, outside of the dictionary lock. Every primitive is safe for concurrent use
+func CreateExpiringHash[K, V any](cmp func(K, K) bool, clock func() time.Time, onExpire func(key K, value V)) ExpiringDictionary[K, V] {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &expiringHash[K, V]{
+		entries: CreateHash[K, *expiringEntry[K, V]](cmp),
+		expirations: TDAPriorityQueue.NewBinaryHeap(func(a, b expiration[K, V]) int {
+			return a.at.Compare(b.at)
+		}),
+		clock:    clock,
+		onExpire: onExpire,
+	}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (dict *expiringHash[K, V]) Save(key K, value V) {
+	dict.save(key, value, time.Time{})
+}
+
+func (dict *expiringHash[K, V]) SaveWithTTL(key K, value V, ttl time.Duration) {
+	if ttl <= 0 {
+		panic(_PANIC_MESSAGE_TTL)
+	}
+	dict.save(key, value, dict.clock().Add(ttl))
+}
+
+func (dict *expiringHash[K, V]) Belongs(key K) bool {
+	dict.mutex.Lock()
+	entry, expired := dict.lookup(key)
+	dict.mutex.Unlock()
+
+	dict.notify(expired)
+	return entry != nil
+}
+
+func (dict *expiringHash[K, V]) Get(key K) V {
+	dict.mutex.Lock()
+	entry, expired := dict.lookup(key)
+	dict.mutex.Unlock()
+
+	dict.notify(expired)
+	if entry == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return entry.value
+}
+
+func (dict *expiringHash[K, V]) Delete(key K) V {
+	dict.mutex.Lock()
+	entry, expired := dict.lookup(key)
+	if entry != nil {
+		dict.remove(entry)
+	}
+	dict.mutex.Unlock()
+
+	dict.notify(expired)
+	if entry == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return entry.value
+}
+
+func (dict *expiringHash[K, V]) Count() int {
+	dict.mutex.Lock()
+	expired := dict.removeExpired()
+	count := dict.entries.Count()
+	dict.mutex.Unlock()
+
+	dict.notify(expired...)
+	return count
+}
+
+// Iterate visits a snapshot of the pairs that had not expired when it was called, so visit may use the dictionary
+func (dict *expiringHash[K, V]) Iterate(visit func(key K, value V) bool) {
+	dict.snapshot().Iterate(func(entry *expiringEntry[K, V]) bool {
+		return visit(entry.key, entry.value)
+	})
+}
+
+// Iterator traverses a snapshot of the pairs that had not expired when it was created
+func (dict *expiringHash[K, V]) Iterator() DictionaryIterator[K, V] {
+	return &iterExpiringHash[K, V]{snapshot: dict.snapshot().Iterator()}
+}
+
+func (dict *expiringHash[K, V]) RemoveExpired() int {
+	dict.mutex.Lock()
+	expired := dict.removeExpired()
+	dict.mutex.Unlock()
+
+	dict.notify(expired...)
+	return len(expired)
+}
+
+func (dict *expiringHash[K, V]) StartJanitor(interval time.Duration) {
+	if interval <= 0 {
+		panic(_PANIC_MESSAGE_INTERVAL)
+	}
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+
+	if dict.stop != nil {
+		panic(_PANIC_MESSAGE_JANITOR)
+	}
+	dict.stop = make(chan struct{})
+	dict.done = make(chan struct{})
+	go dict.janitor(interval, dict.stop, dict.done)
+}
+
+func (dict *expiringHash[K, V]) StopJanitor() {
+	dict.mutex.Lock()
+	stop, done := dict.stop, dict.done
+	dict.stop, dict.done = nil, nil
+	dict.mutex.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterExpiringHash[K, V]) HasNext() bool {
+	return iter.snapshot.HasNext()
+}
+
+func (iter *iterExpiringHash[K, V]) Current() (K, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	entry := iter.snapshot.Current()
+	return entry.key, entry.value
+}
+
+func (iter *iterExpiringHash[K, V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	iter.snapshot.Next()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func (dict *expiringHash[K, V]) save(key K, value V, expireAt time.Time) {
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+
+	if dict.entries.Belongs(key) {
+		dict.remove(dict.entries.Get(key))
+	}
+
+	entry := &expiringEntry[K, V]{key: key, value: value, expireAt: expireAt}
+	if !expireAt.IsZero() {
+		entry.handle = dict.expirations.Insert(expiration[K, V]{expireAt, entry})
+	}
+	dict.entries.Save(key, entry)
+}
+
+func (dict *expiringHash[K, V]) snapshot() TDAList.List[*expiringEntry[K, V]] {
+	dict.mutex.Lock()
+	expired := dict.removeExpired()
+	snapshot := TDAList.CreateLinkedList[*expiringEntry[K, V]]()
+	dict.entries.Iterate(func(_ K, entry *expiringEntry[K, V]) bool {
+		snapshot.InsertLast(entry)
+		return true
+	})
+	dict.mutex.Unlock()
+
+	dict.notify(expired...)
+	return snapshot
+}
+
+// The following functions must be called while holding the mutex
+
+// lookup returns the entry of the key, or nil if there is none. If the entry had expired, it is removed and
+// returned as the second result instead
+func (dict *expiringHash[K, V]) lookup(key K) (*expiringEntry[K, V], *expiringEntry[K, V]) {
+	if !dict.entries.Belongs(key) {
+		return nil, nil
+	}
+
+	entry := dict.entries.Get(key)
+	if dict.hasExpired(entry, dict.clock()) {
+		dict.remove(entry)
+		return nil, entry
+	}
+	return entry, nil
+}
+
+func (dict *expiringHash[K, V]) remove(entry *expiringEntry[K, V]) {
+	dict.entries.Delete(entry.key)
+	if entry.handle != nil {
+		// The zero time goes before any expiration, so the entry reaches the top of the heap
+		dict.expirations.DecreaseKey(entry.handle, expiration[K, V]{time.Time{}, entry})
+		dict.expirations.Dequeue()
+	}
+}
+
+func (dict *expiringHash[K, V]) removeExpired() []*expiringEntry[K, V] {
+	var expired []*expiringEntry[K, V]
+	now := dict.clock()
+	for !dict.expirations.IsEmpty() && dict.hasExpired(dict.expirations.Peek().entry, now) {
+		entry := dict.expirations.Dequeue().entry
+		dict.entries.Delete(entry.key)
+		expired = append(expired, entry)
+	}
+	return expired
+}
+
+func (dict *expiringHash[K, V]) hasExpired(entry *expiringEntry[K, V], now time.Time) bool {
+	return !entry.expireAt.IsZero() && !now.Before(entry.expireAt)
+}
+
+// The following functions must be called without holding the mutex
+
+func (dict *expiringHash[K, V]) notify(expired ...*expiringEntry[K, V]) {
+	if dict.onExpire == nil {
+		return
+	}
+	for _, entry := range expired {
+		if entry != nil {
+			dict.onExpire(entry.key, entry.value)
+		}
+	}
+}
+
+func (dict *expiringHash[K, V]) janitor(interval time.Duration, stop <-chan struct{}, done chan<- struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer close(done)
+
+	for {
+		select {
+		case <-ticker.C:
+			dict.RemoveExpired()
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock that only moves when the test advances it
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+}
+
+func TestExpiringHashBehavesAsDictionary(t *testing.T) {
+	t.Log("Pairs saved without TTL never expire, and behave as in any other dictionary")
+	clock := newFakeClock()
+	dict := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	require.False(t, dict.Belongs("A"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("A") })
+
+	dict.Save("A", 1)
+	dict.Save("B", 2)
+	clock.Advance(1000 * time.Hour)
+	require.EqualValues(t, 2, dict.Count())
+	require.EqualValues(t, 1, dict.Get("A"))
+	require.EqualValues(t, 2, dict.Delete("B"))
+	require.False(t, dict.Belongs("B"))
+}
+
+func TestExpiringHashLazyExpiry(t *testing.T) {
+	t.Log("Get and Belongs treat expired pairs as deleted, and report them to the callback")
+	clock := newFakeClock()
+	var expired []string
+	dict := TDADictionary.CreateExpiringHash(stringEquality, clock.Now, func(key string, _ int) {
+		expired = append(expired, key)
+	})
+	dict.SaveWithTTL("session", 1, time.Minute)
+
+	clock.Advance(59 * time.Second)
+	require.True(t, dict.Belongs("session"))
+	require.EqualValues(t, 1, dict.Get("session"))
+
+	clock.Advance(time.Second)
+	require.False(t, dict.Belongs("session"))
+	require.Equal(t, []string{"session"}, expired)
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("session") })
+	require.Equal(t, []string{"session"}, expired, "A pair expires only once")
+}
+
+func TestExpiringHashSaveResetsTTL(t *testing.T) {
+	t.Log("Saving a key again replaces its expiration, or removes it when saved without TTL")
+	clock := newFakeClock()
+	dict := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	dict.SaveWithTTL("A", 1, time.Minute)
+	dict.SaveWithTTL("B", 2, time.Minute)
+
+	clock.Advance(30 * time.Second)
+	dict.SaveWithTTL("A", 10, time.Minute)
+	dict.Save("B", 20)
+
+	clock.Advance(45 * time.Second)
+	require.EqualValues(t, 10, dict.Get("A"))
+	require.EqualValues(t, 20, dict.Get("B"))
+
+	clock.Advance(time.Hour)
+	require.False(t, dict.Belongs("A"))
+	require.True(t, dict.Belongs("B"))
+	require.PanicsWithValue(t, "The TTL must be positive", func() { dict.SaveWithTTL("C", 3, 0) })
+}
+
+func TestExpiringHashCountAndIterationSkipExpired(t *testing.T) {
+	t.Log("Count and both iterators only see the pairs that have not expired")
+	clock := newFakeClock()
+	dict := TDADictionary.CreateExpiringHash[int, int](intEquality, clock.Now, nil)
+	for i := 0; i < 10; i++ {
+		dict.SaveWithTTL(i, i, time.Duration(i+1)*time.Second)
+	}
+
+	clock.Advance(5 * time.Second)
+	require.EqualValues(t, 5, dict.Count())
+
+	seen := 0
+	dict.Iterate(func(key int, value int) bool {
+		require.GreaterOrEqual(t, key, 5)
+		require.True(t, dict.Belongs(key), "The dictionary can be used while iterating")
+		seen++
+		return true
+	})
+	require.EqualValues(t, 5, seen)
+
+	seen = 0
+	for iter := dict.Iterator(); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		require.GreaterOrEqual(t, key, 5)
+		seen++
+	}
+	require.EqualValues(t, 5, seen)
+}
+
+func TestExpiringHashRemoveExpired(t *testing.T) {
+	t.Log("RemoveExpired purges every expired pair at once, without touching the rest")
+	clock := newFakeClock()
+	expired := 0
+	dict := TDADictionary.CreateExpiringHash(intEquality, clock.Now, func(int, int) { expired++ })
+	for i := 0; i < 1000; i++ {
+		dict.SaveWithTTL(i, i, time.Duration(i%10+1)*time.Minute)
+	}
+	dict.Delete(0)
+
+	clock.Advance(5 * time.Minute)
+	require.EqualValues(t, 499, dict.RemoveExpired())
+	require.EqualValues(t, 499, expired)
+	require.EqualValues(t, 0, dict.RemoveExpired())
+	require.EqualValues(t, 500, dict.Count())
+}
+
+func TestExpiringHashJanitor(t *testing.T) {
+	t.Log("The janitor removes expired pairs in the background until it is stopped")
+	clock := newFakeClock()
+	expired := make(chan string, 10)
+	dict := TDADictionary.CreateExpiringHash(stringEquality, clock.Now, func(key string, _ int) {
+		expired <- key
+	})
+	dict.SaveWithTTL("A", 1, time.Second)
+
+	require.PanicsWithValue(t, "The interval must be positive", func() { dict.StartJanitor(0) })
+	require.PanicsWithValue(t, "The interval must be positive", func() { dict.StartJanitor(-time.Second) })
+	dict.StartJanitor(time.Millisecond)
+	require.PanicsWithValue(t, "The janitor is already running", func() { dict.StartJanitor(time.Millisecond) })
+	clock.Advance(time.Second)
+
+	select {
+	case key := <-expired:
+		require.EqualValues(t, "A", key)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "The janitor did not remove the expired pair")
+	}
+
+	dict.StopJanitor()
+	dict.StopJanitor()
+	dict.SaveWithTTL("B", 2, time.Second)
+	clock.Advance(time.Second)
+	time.Sleep(20 * time.Millisecond)
+	require.Empty(t, expired, "A stopped janitor does not remove anything")
+
+	dict.StartJanitor(time.Millisecond)
+	require.EqualValues(t, "B", <-expired)
+	dict.StopJanitor()
+}
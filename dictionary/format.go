@@ -0,0 +1,275 @@
+package dictionary
+
+import (
+	TDAList "adts/list"
+	"cmp"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// textPair is a pair already formatted, which keeps its key to sort the pairs of a hash as fmt sorts maps
+type textPair struct {
+	key   string
+	value string
+	order reflect.Value
+}
+
+// FormatDictionary formats the pairs of the dictionary, in the order Iterate visits them, as fmt formats maps:
+// map{a:1 b:2}. The ordered dictionaries of this package use it as their String method, and other implementations
+// of Dictionary can do the same
+func FormatDictionary[K, V any](dict Dictionary[K, V]) string {
+	return "map" + joinPairs(textPairs(dict.Iterate, formatText[K], formatText[V], false), ":", " ")
+}
+
+// GoFormatDictionary formats the dictionary as Go syntax, like typeName{"a": 1, "b": 2}, with the pairs in the order
+// Iterate visits them
+func GoFormatDictionary[K, V any](typeName string, dict Dictionary[K, V]) string {
+	return typeName + joinPairs(textPairs(dict.Iterate, formatGo[K], formatGo[V], false), ": ", ", ")
+}
+
+// PrintTree writes the tree that hangs from root, one node per line below its parent, with branches drawn as the
+// tree command does. children returns the children of a node from the first to the last, and label the text of a
+// node. Tree-based dictionaries use it to implement Dumper
+func PrintTree[N any](w io.Writer, root N, children func(N) []N, label func(N) string) error {
+	var text strings.Builder
+	text.WriteString(label(root) + "\n")
+	printChildren(&text, root, "", children, label)
+	_, err := io.WriteString(w, text.String())
+	return err
+}
+
+// -------------------- FORMAT PRIMITIVES --------------------
+
+func (hash *openHash[K, V]) String() string {
+	return "map" + joinPairs(textPairs(hash.Iterate, formatText[K], formatText[V], true), ":", " ")
+}
+
+func (hash *openHash[K, V]) GoString() string {
+	return goTypeName[K, V]("dictionary.Hash") + joinPairs(textPairs(hash.Iterate, formatGo[K], formatGo[V], true), ": ", ", ")
+}
+
+// Dump writes the load factor of the hash, the chain of every bucket of its table and how many chains there are of
+// each length
+func (hash *openHash[K, V]) Dump(w io.Writer) error {
+	var text strings.Builder
+	var lengths []int
+	occupied := 0
+	for i, list := range hash.table {
+		length := list.Length()
+		for len(lengths) <= length {
+			lengths = append(lengths, 0)
+		}
+		lengths[length]++
+		if length > 0 {
+			occupied++
+		}
+
+		fmt.Fprintf(&text, "bucket %d [%d]", i, length)
+		list.Iterate(func(pair *keyValuePair[K, V]) bool {
+			fmt.Fprintf(&text, " %v:%v", pair.key, pair.value)
+			return true
+		})
+		text.WriteString("\n")
+	}
+	for length, buckets := range lengths {
+		fmt.Fprintf(&text, "chains of length %d: %d\n", length, buckets)
+	}
+
+	header := fmt.Sprintf("hash: %d pairs in %d buckets, %d occupied, load factor %.2f\n",
+		hash.count, hash.size, occupied, float64(hash.count)/float64(hash.size))
+	_, err := io.WriteString(w, header+text.String())
+	return err
+}
+
+func (tree *avlTree[K, V]) String() string {
+	return FormatDictionary[K, V](tree)
+}
+
+func (tree *avlTree[K, V]) GoString() string {
+	return GoFormatDictionary[K, V](goTypeName[K, V]("dictionary.AVL"), tree)
+}
+
+// Dump writes the nodes of the tree with their heights, showing the missing child of a node that has only one
+func (tree *avlTree[K, V]) Dump(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "avl: %d pairs, height %d\n", tree.count, height(tree.root)); err != nil {
+		return err
+	}
+	children := func(node *avlNode[K, V]) []*avlNode[K, V] {
+		if node == nil || (node.left == nil && node.right == nil) {
+			return nil
+		}
+		return []*avlNode[K, V]{node.left, node.right}
+	}
+	label := func(node *avlNode[K, V]) string {
+		if node == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("%v:%v (height %d)", node.key, node.value, node.height)
+	}
+	return PrintTree(w, tree.root, children, label)
+}
+
+func (tree *bTree[K, V]) String() string {
+	return FormatDictionary[K, V](tree)
+}
+
+func (tree *bTree[K, V]) GoString() string {
+	return GoFormatDictionary[K, V](goTypeName[K, V]("dictionary.BTree"), tree)
+}
+
+// Dump writes every node of the tree with its pairs
+func (tree *bTree[K, V]) Dump(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "btree: %d pairs, degree %d\n", tree.count, tree.degree); err != nil {
+		return err
+	}
+	children := func(node *bTreeNode[K, V]) []*bTreeNode[K, V] {
+		return node.children
+	}
+	label := func(node *bTreeNode[K, V]) string {
+		pairs := make([]string, len(node.keys))
+		for i := range node.keys {
+			pairs[i] = fmt.Sprintf("%v:%v", node.keys[i], node.values[i])
+		}
+		return "[" + strings.Join(pairs, " ") + "]"
+	}
+	return PrintTree(w, tree.root, children, label)
+}
+
+func (list *skipList[K, V]) String() string {
+	return FormatDictionary[K, V](list)
+}
+
+func (list *skipList[K, V]) GoString() string {
+	return GoFormatDictionary[K, V](goTypeName[K, V]("dictionary.SkipList"), list)
+}
+
+// String only shows the pairs that have not expired
+func (dict *expiringHash[K, V]) String() string {
+	return "map" + joinPairs(textPairs(dict.Iterate, formatText[K], formatText[V], true), ":", " ")
+}
+
+func (dict *expiringHash[K, V]) GoString() string {
+	return goTypeName[K, V]("dictionary.ExpiringHash") + joinPairs(textPairs(dict.Iterate, formatGo[K], formatGo[V], true), ": ", ", ")
+}
+
+func (dict *persistentHash[K, V]) String() string {
+	return "map" + joinPairs(textPairs(dict.Iterate, formatText[K], formatText[V], true), ":", " ")
+}
+
+func (dict *persistentHash[K, V]) GoString() string {
+	return goTypeName[K, V]("dictionary.PersistentHash") + joinPairs(textPairs(dict.Iterate, formatGo[K], formatGo[V], true), ": ", ", ")
+}
+
+// String shows the values of every key in the order they were added, like multimap{a:[1 2] b:[3]}
+func (multi *multiHash[K, V]) String() string {
+	return "multimap" + joinPairs(textPairs(multi.index.Iterate, formatText[K], formatValues(formatText[V], " ", "[", "]"), true), ":", " ")
+}
+
+func (multi *multiHash[K, V]) GoString() string {
+	return goTypeName[K, V]("dictionary.MultiHash") + joinPairs(textPairs(multi.index.Iterate, formatGo[K], formatValues(formatGo[V], ", ", "{", "}"), true), ": ", ", ")
+}
+
+// String shows the number of occurrences of every element, like bag{a:2 b:1}
+func (bag *hashBag[T]) String() string {
+	return "bag" + joinPairs(textPairs(bag.Iterate, formatText[T], formatText[int], true), ":", " ")
+}
+
+func (bag *hashBag[T]) GoString() string {
+	return fmt.Sprintf("dictionary.Bag[%v]", reflect.TypeFor[T]()) + joinPairs(textPairs(bag.Iterate, formatGo[T], formatGo[int], true), ": ", ", ")
+}
+
+func (bimap *biMap[K, V]) String() string {
+	return "bimap" + joinPairs(textPairs(bimap.Iterate, formatText[K], formatText[V], true), ":", " ")
+}
+
+func (bimap *biMap[K, V]) GoString() string {
+	return goTypeName[K, V]("dictionary.BiMap") + joinPairs(textPairs(bimap.Iterate, formatGo[K], formatGo[V], true), ": ", ", ")
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func textPairs[K, V any](iterate func(func(K, V) bool), formatKey func(K) string, formatValue func(V) string, sortByKey bool) []textPair {
+	var pairs []textPair
+	iterate(func(key K, value V) bool {
+		pairs = append(pairs, textPair{formatKey(key), formatValue(value), reflect.ValueOf(key)})
+		return true
+	})
+	if sortByKey {
+		sort.SliceStable(pairs, func(i, j int) bool { return compareKeys(pairs[i], pairs[j]) < 0 })
+	}
+	return pairs
+}
+
+// compareKeys compares the keys of two pairs as fmt does when it sorts a map: numbers, strings and booleans by their
+// value, and any other key by its text
+func compareKeys(a, b textPair) int {
+	x, y := a.order, b.order
+	if x.Kind() == y.Kind() {
+		switch x.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(x.Int(), y.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(x.Uint(), y.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(x.Float(), y.Float())
+		case reflect.String:
+			return cmp.Compare(x.String(), y.String())
+		case reflect.Bool:
+			return cmp.Compare(boolOrder(x.Bool()), boolOrder(y.Bool()))
+		}
+	}
+	return cmp.Compare(a.key, b.key)
+}
+
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func joinPairs(pairs []textPair, between string, separator string) string {
+	texts := make([]string, len(pairs))
+	for i, pair := range pairs {
+		texts[i] = pair.key + between + pair.value
+	}
+	return "{" + strings.Join(texts, separator) + "}"
+}
+
+func formatText[T any](element T) string {
+	return fmt.Sprint(element)
+}
+
+func formatGo[T any](element T) string {
+	return fmt.Sprintf("%#v", element)
+}
+
+func formatValues[V any](format func(V) string, separator, open, close string) func(TDAList.List[V]) string {
+	return func(values TDAList.List[V]) string {
+		texts := make([]string, 0, values.Length())
+		values.Iterate(func(value V) bool {
+			texts = append(texts, format(value))
+			return true
+		})
+		return open + strings.Join(texts, separator) + close
+	}
+}
+
+func goTypeName[K, V any](name string) string {
+	return fmt.Sprintf("%s[%v, %v]", name, reflect.TypeFor[K](), reflect.TypeFor[V]())
+}
+
+func printChildren[N any](text *strings.Builder, node N, indent string, children func(N) []N, label func(N) string) {
+	nodes := children(node)
+	for i, child := range nodes {
+		branch, next := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, next = "└── ", "    "
+		}
+		text.WriteString(indent + branch + label(child) + "\n")
+		printChildren(text, child, indent+next, children, label)
+	}
+}
@@ -0,0 +1,149 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDictionaries(t *testing.T) {
+	t.Log("Dictionaries are printed as fmt prints maps, the hashes sorted by key and the ordered ones in their order")
+	for name, create := range binaryDictionaries {
+		dict := create()
+		require.Equal(t, "map{}", fmt.Sprint(dict), name)
+		dict.Save("b", 2)
+		dict.Save("a", 1)
+		dict.Save("c", 3)
+		require.Equal(t, "map{a:1 b:2 c:3}", fmt.Sprint(dict), name)
+		require.Equal(t, "map{a:1 b:2 c:3}", fmt.Sprintf("%v", dict), name)
+		require.Equal(t, fmt.Sprintf(`dictionary.%s[string, int]{"a": 1, "b": 2, "c": 3}`, name), fmt.Sprintf("%#v", dict), name)
+	}
+
+	numbers := TDADictionary.CreateAVL[int, string](func(a, b int) int { return a - b })
+	numbers.Save(10, "ten")
+	numbers.Save(2, "two")
+	require.Equal(t, "map{2:two 10:ten}", fmt.Sprint(numbers))
+	require.Equal(t, `dictionary.AVL[int, string]{2: "two", 10: "ten"}`, fmt.Sprintf("%#v", numbers))
+
+	hash := TDADictionary.CreateHash[int, string](intEquality)
+	for _, n := range []int{10, 2, -3, 100} {
+		hash.Save(n, fmt.Sprint(n))
+	}
+	require.Equal(t, "map{-3:-3 2:2 10:10 100:100}", fmt.Sprint(hash), "The keys are sorted by value, not by text")
+	require.Equal(t, `dictionary.Hash[int, string]{-3: "-3", 2: "2", 10: "10", 100: "100"}`, fmt.Sprintf("%#v", hash))
+}
+
+func TestFormatOtherDictionaries(t *testing.T) {
+	t.Log("Every kind of dictionary has its own text")
+	multi := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	multi.Add("b", 3)
+	multi.Add("a", 1)
+	multi.Add("a", 2)
+	require.Equal(t, "multimap{a:[1 2] b:[3]}", fmt.Sprint(multi))
+	require.Equal(t, `dictionary.MultiHash[string, int]{"a": {1, 2}, "b": {3}}`, fmt.Sprintf("%#v", multi))
+
+	bag := TDADictionary.CreateBag[string](stringEquality)
+	bag.Add("x", 2)
+	bag.Add("w", 1)
+	require.Equal(t, "bag{w:1 x:2}", fmt.Sprint(bag))
+	require.Equal(t, `dictionary.Bag[string]{"w": 1, "x": 2}`, fmt.Sprintf("%#v", bag))
+
+	bimap := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.PanicOnConflict)
+	bimap.Save("a", 1)
+	require.Equal(t, "bimap{a:1}", fmt.Sprint(bimap))
+	require.Equal(t, "bimap{1:a}", fmt.Sprint(bimap.Inverse()))
+	require.Equal(t, `dictionary.BiMap[int, string]{1: "a"}`, fmt.Sprintf("%#v", bimap.Inverse()))
+
+	clock := newFakeClock()
+	expiring := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	expiring.Save("a", 1)
+	expiring.SaveWithTTL("b", 2, time.Second)
+	require.Equal(t, "map{a:1 b:2}", fmt.Sprint(expiring))
+	clock.Advance(time.Minute)
+	require.Equal(t, "map{a:1}", fmt.Sprint(expiring), "The expired pairs are not printed")
+	require.Equal(t, `dictionary.ExpiringHash[string, int]{"a": 1}`, fmt.Sprintf("%#v", expiring))
+
+	persistent := openPersistent(t, filepath.Join(t.TempDir(), "log"), TDADictionary.SyncNever)
+	defer persistent.Close()
+	persistent.Save("a", 1)
+	require.Equal(t, "map{a:1}", fmt.Sprint(persistent))
+	require.Equal(t, `dictionary.PersistentHash[string, int]{"a": 1}`, fmt.Sprintf("%#v", persistent))
+}
+
+func TestDumpHash(t *testing.T) {
+	t.Log("The dump of a hash shows every bucket and how many chains there are of each length")
+	hash := TDADictionary.CreateHash[int, int](intEquality)
+	for i := 0; i < 20; i++ {
+		hash.Save(i, i*i)
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, hash.(TDADictionary.Dumper).Dump(&buffer))
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	require.Regexp(t, `^hash: 20 pairs in \d+ buckets, \d+ occupied, load factor \d+\.\d\d$`, lines[0])
+
+	buckets, pairs, chains := 0, 0, 0
+	for _, line := range lines[1:] {
+		var number, length int
+		if _, err := fmt.Sscanf(line, "bucket %d [%d]", &number, &length); err == nil {
+			require.Equal(t, buckets, number)
+			require.Len(t, strings.Fields(line), 3+length)
+			buckets++
+			pairs += length
+		} else {
+			_, err := fmt.Sscanf(line, "chains of length %d: %d", &length, &number)
+			require.NoError(t, err, line)
+			chains += number
+		}
+	}
+	require.Equal(t, 20, pairs)
+	require.Equal(t, buckets, chains)
+	require.Contains(t, buffer.String(), " 7:49")
+}
+
+func TestDumpTrees(t *testing.T) {
+	t.Log("Tree-based dictionaries dump their nodes with PrintTree")
+	avl := TDADictionary.CreateAVL[string, int](strings.Compare)
+	btree := TDADictionary.CreateBTree[string, int](strings.Compare, 2)
+	for i, key := range []string{"b", "a", "c", "d"} {
+		avl.Save(key, i)
+		btree.Save(key, i)
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, avl.(TDADictionary.Dumper).Dump(&buffer))
+	require.Equal(t, "avl: 4 pairs, height 3\n"+
+		"b:0 (height 3)\n"+
+		"├── a:1 (height 1)\n"+
+		"└── c:2 (height 2)\n"+
+		"    ├── nil\n"+
+		"    └── d:3 (height 1)\n", buffer.String())
+
+	buffer.Reset()
+	require.NoError(t, btree.(TDADictionary.Dumper).Dump(&buffer))
+	require.Equal(t, "btree: 4 pairs, degree 2\n"+
+		"[b:0]\n"+
+		"├── [a:1]\n"+
+		"└── [c:2 d:3]\n", buffer.String())
+}
+
+func TestPrintTree(t *testing.T) {
+	t.Log("PrintTree draws any tree given the children and the label of its nodes")
+	children := map[string][]string{"root": {"x", "y"}, "x": {"x1", "x2"}, "y": {"y1"}}
+	var buffer bytes.Buffer
+	err := TDADictionary.PrintTree(&buffer, "root",
+		func(node string) []string { return children[node] },
+		func(node string) string { return strings.ToUpper(node) })
+	require.NoError(t, err)
+	require.Equal(t, "ROOT\n"+
+		"├── X\n"+
+		"│   ├── X1\n"+
+		"│   └── X2\n"+
+		"└── Y\n"+
+		"    └── Y1\n", buffer.String())
+}
@@ -10,7 +10,7 @@ const (
 	_PANIC_MESSAGE_ITER       = "The iterator has finished iterating"
 	_INITIAL_SIZE             = 7
 	_MAX_LOAD_FACTOR          = 3.0
-	_MIN_LOAD_FACTOR          = 2.0
+	_MIN_LOAD_FACTOR          = 1.0
 	_RESIZE_FACTOR            = 2
 )
 
@@ -176,6 +176,37 @@ func (hash *openHash[K, V]) hashSearch(key K) iterKeyValuePairList[K, V] {
 	return iter
 }
 
+// update replaces the value associated with key by the one returned by modify, which receives the current value
+// and whether the key belongs to the hash. If modify returns keep as false, the key is removed instead. Every
+// step shares a single search, so the key is hashed only once
+func (hash *openHash[K, V]) update(key K, modify func(value V, found bool) (newValue V, keep bool)) {
+	iter := hash.hashSearch(key)
+	found := iter.HasNext()
+
+	var current V
+	if found {
+		current = iter.Current().value
+	}
+	newValue, keep := modify(current, found)
+
+	switch {
+	case found && keep:
+		iter.Current().value = newValue
+	case found:
+		iter.Remove()
+		hash.count--
+		if float32(hash.count)/float32(hash.size) <= _MIN_LOAD_FACTOR && hash.size > _INITIAL_SIZE {
+			hash.rehash(hash.size / _RESIZE_FACTOR)
+		}
+	case keep:
+		iter.Insert(createPair(key, newValue))
+		hash.count++
+		if float32(hash.count)/float32(hash.size) >= _MAX_LOAD_FACTOR {
+			hash.rehash(hash.size * _RESIZE_FACTOR)
+		}
+	}
+}
+
 func (hash *openHash[K, V]) rehash(newSize int) {
 	newTable := createTable[K, V](newSize)
 
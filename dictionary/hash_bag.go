@@ -0,0 +1,125 @@
+package dictionary
+
+import (
+	TDAList "adts/list"
+	TDAPriorityQueue "adts/priorityqueue"
+)
+
+const (
+	_PANIC_MESSAGE_AMOUNT      = "The amount must be positive"
+	_PANIC_MESSAGE_OCCURRENCES = "The bag does not have enough occurrences of the element"
+)
+
+type hashBag[T any] struct {
+	counts *openHash[T, int]
+	total  int
+	cmp    func(T, T) bool
+}
+
+// CreateBag creates a Bag that keeps the number of occurrences of each element in a hash. Adding or removing
+// occurrences hashes the element only once
+func CreateBag[T any](cmp func(T, T) bool) Bag[T] {
+	return &hashBag[T]{counts: CreateHash[T, int](cmp).(*openHash[T, int]), cmp: cmp}
+}
+
+// -------------------- BAG PRIMITIVES --------------------
+
+func (bag *hashBag[T]) Add(element T, n int) {
+	if n <= 0 {
+		panic(_PANIC_MESSAGE_AMOUNT)
+	}
+
+	bag.counts.update(element, func(count int, _ bool) (int, bool) {
+		return count + n, true
+	})
+	bag.total += n
+}
+
+func (bag *hashBag[T]) Remove(element T, n int) {
+	if n <= 0 {
+		panic(_PANIC_MESSAGE_AMOUNT)
+	}
+
+	bag.counts.update(element, func(count int, _ bool) (int, bool) {
+		if count < n {
+			panic(_PANIC_MESSAGE_OCCURRENCES)
+		}
+		return count - n, count > n
+	})
+	bag.total -= n
+}
+
+func (bag *hashBag[T]) Count(element T) int {
+	iter := bag.counts.hashSearch(element)
+	if !iter.HasNext() {
+		return 0
+	}
+	return iter.Current().value
+}
+
+func (bag *hashBag[T]) Distinct() int {
+	return bag.counts.Count()
+}
+
+func (bag *hashBag[T]) Total() int {
+	return bag.total
+}
+
+func (bag *hashBag[T]) MostCommon(k int) TDAList.List[BagEntry[T]] {
+	// The heap keeps the k most frequent elements seen so far, with the least frequent of them on top
+	heap := TDAPriorityQueue.NewBinaryHeap(func(a, b BagEntry[T]) int {
+		return a.Count - b.Count
+	})
+	bag.Iterate(func(element T, count int) bool {
+		if heap.Count() < k {
+			heap.Enqueue(BagEntry[T]{element, count})
+		} else if k > 0 && heap.Peek().Count < count {
+			heap.Dequeue()
+			heap.Enqueue(BagEntry[T]{element, count})
+		}
+		return true
+	})
+
+	result := TDAList.CreateLinkedList[BagEntry[T]]()
+	for !heap.IsEmpty() {
+		result.InsertFirst(heap.Dequeue())
+	}
+	return result
+}
+
+func (bag *hashBag[T]) Union(other Bag[T]) Bag[T] {
+	result := bag.copy()
+	other.Iterate(func(element T, count int) bool {
+		if missing := count - result.Count(element); missing > 0 {
+			result.Add(element, missing)
+		}
+		return true
+	})
+	return result
+}
+
+func (bag *hashBag[T]) Intersection(other Bag[T]) Bag[T] {
+	result := CreateBag[T](bag.cmp)
+	bag.Iterate(func(element T, count int) bool {
+		if common := min(count, other.Count(element)); common > 0 {
+			result.Add(element, common)
+		}
+		return true
+	})
+	return result
+}
+
+func (bag *hashBag[T]) Iterate(visit func(element T, count int) bool) {
+	bag.counts.Iterate(visit)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func (bag *hashBag[T]) copy() *hashBag[T] {
+	result := CreateBag[T](bag.cmp).(*hashBag[T])
+	bag.Iterate(func(element T, count int) bool {
+		result.Add(element, count)
+		return true
+	})
+	return result
+}
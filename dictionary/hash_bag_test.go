@@ -0,0 +1,115 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBagEmpty(t *testing.T) {
+	t.Log("Check that an empty Bag has no occurrences")
+	bag := TDADictionary.CreateBag[string](stringEquality)
+	require.EqualValues(t, 0, bag.Count("A"))
+	require.EqualValues(t, 0, bag.Distinct())
+	require.EqualValues(t, 0, bag.Total())
+	require.True(t, bag.MostCommon(3).IsEmpty())
+	require.PanicsWithValue(t, "The bag does not have enough occurrences of the element", func() { bag.Remove("A", 1) })
+}
+
+func TestBagAddAndRemove(t *testing.T) {
+	t.Log("Adding and removing occurrences updates the count of the element and the totals")
+	bag := TDADictionary.CreateBag[string](stringEquality)
+	bag.Add("A", 2)
+	bag.Add("B", 1)
+	bag.Add("A", 3)
+	require.EqualValues(t, 5, bag.Count("A"))
+	require.EqualValues(t, 1, bag.Count("B"))
+	require.EqualValues(t, 2, bag.Distinct())
+	require.EqualValues(t, 6, bag.Total())
+
+	bag.Remove("A", 4)
+	require.EqualValues(t, 1, bag.Count("A"))
+	require.PanicsWithValue(t, "The bag does not have enough occurrences of the element", func() { bag.Remove("A", 2) })
+	require.EqualValues(t, 1, bag.Count("A"))
+
+	bag.Remove("A", 1)
+	require.EqualValues(t, 0, bag.Count("A"))
+	require.EqualValues(t, 1, bag.Distinct())
+	require.EqualValues(t, 1, bag.Total())
+}
+
+func TestBagInvalidAmounts(t *testing.T) {
+	t.Log("Amounts that are not positive are rejected")
+	bag := TDADictionary.CreateBag[int](intEquality)
+	require.PanicsWithValue(t, "The amount must be positive", func() { bag.Add(1, 0) })
+	require.PanicsWithValue(t, "The amount must be positive", func() { bag.Add(1, -2) })
+	require.PanicsWithValue(t, "The amount must be positive", func() { bag.Remove(1, 0) })
+	require.EqualValues(t, 0, bag.Total())
+}
+
+func TestBagMostCommon(t *testing.T) {
+	t.Log("MostCommon returns the most frequent words, from the most to the least frequent")
+	bag := TDADictionary.CreateBag[string](stringEquality)
+	text := "the cat and the dog and the bird saw the cat"
+	for _, word := range strings.Fields(text) {
+		bag.Add(word, 1)
+	}
+
+	common := bag.MostCommon(2)
+	require.EqualValues(t, 2, common.Length())
+	require.Equal(t, TDADictionary.BagEntry[string]{Element: "the", Count: 4}, common.RemoveFirst())
+	second := common.RemoveFirst()
+	require.EqualValues(t, 2, second.Count)
+	require.Contains(t, []string{"cat", "and"}, second.Element)
+
+	require.EqualValues(t, bag.Distinct(), bag.MostCommon(100).Length())
+	require.True(t, bag.MostCommon(0).IsEmpty())
+}
+
+func TestBagUnionAndIntersection(t *testing.T) {
+	t.Log("Union keeps the maximum number of occurrences of each element, and intersection the minimum")
+	first := TDADictionary.CreateBag[string](stringEquality)
+	first.Add("A", 3)
+	first.Add("B", 1)
+	second := TDADictionary.CreateBag[string](stringEquality)
+	second.Add("A", 1)
+	second.Add("B", 2)
+	second.Add("C", 5)
+
+	union := first.Union(second)
+	require.EqualValues(t, 3, union.Count("A"))
+	require.EqualValues(t, 2, union.Count("B"))
+	require.EqualValues(t, 5, union.Count("C"))
+	require.EqualValues(t, 10, union.Total())
+
+	intersection := first.Intersection(second)
+	require.EqualValues(t, 1, intersection.Count("A"))
+	require.EqualValues(t, 1, intersection.Count("B"))
+	require.EqualValues(t, 0, intersection.Count("C"))
+	require.EqualValues(t, 2, intersection.Distinct())
+
+	require.EqualValues(t, 4, first.Total(), "The operands are left untouched")
+	require.EqualValues(t, 8, second.Total(), "The operands are left untouched")
+}
+
+func TestBagVolume(t *testing.T) {
+	t.Log("Counting many occurrences of many elements")
+	bag := TDADictionary.CreateBag[int](intEquality)
+	for i := 0; i < 100000; i++ {
+		bag.Add(i%1000, 1)
+	}
+	require.EqualValues(t, 1000, bag.Distinct())
+	require.EqualValues(t, 100000, bag.Total())
+
+	bag.Iterate(func(element int, count int) bool {
+		require.EqualValues(t, 100, count)
+		return true
+	})
+	for i := 0; i < 1000; i++ {
+		bag.Remove(i, 100)
+	}
+	require.EqualValues(t, 0, bag.Distinct())
+	require.EqualValues(t, 0, bag.Total())
+}
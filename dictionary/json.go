@@ -0,0 +1,287 @@
+package dictionary
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errPair = errors.New("dictionary: every pair must be an array with a key and a value")
+
+// MarshalDictionary encodes the pairs of the dictionary, in the order Iterate visits them, as a JSON object if the
+// keys are strings and as an array of [key, value] arrays otherwise. The dictionaries of this package use it as
+// their MarshalJSON method, and other implementations of Dictionary can do the same
+func MarshalDictionary[K, V any](dict Dictionary[K, V]) ([]byte, error) {
+	return marshalPairs(dict.Iterate)
+}
+
+// UnmarshalDictionary replaces the pairs of the dictionary with those of a JSON value written by MarshalDictionary.
+// A JSON null leaves the dictionary as it is, and a value that cannot be decoded returns an error without changing it
+func UnmarshalDictionary[K, V any](data []byte, dict Dictionary[K, V]) error {
+	if isNull(data) {
+		return nil
+	}
+	pairs, err := unmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+
+	clearDictionary(dict.Iterate, func(key K) { dict.Delete(key) })
+	for _, pair := range pairs {
+		dict.Save(pair.key, pair.value)
+	}
+	return nil
+}
+
+// -------------------- JSON PRIMITIVES --------------------
+
+func (hash *openHash[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalDictionary[K, V](hash)
+}
+
+func (hash *openHash[K, V]) UnmarshalJSON(data []byte) error {
+	return UnmarshalDictionary[K, V](data, hash)
+}
+
+func (tree *avlTree[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalDictionary[K, V](tree)
+}
+
+func (tree *avlTree[K, V]) UnmarshalJSON(data []byte) error {
+	return UnmarshalDictionary[K, V](data, tree)
+}
+
+func (tree *bTree[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalDictionary[K, V](tree)
+}
+
+func (tree *bTree[K, V]) UnmarshalJSON(data []byte) error {
+	return UnmarshalDictionary[K, V](data, tree)
+}
+
+func (list *skipList[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalDictionary[K, V](list)
+}
+
+func (list *skipList[K, V]) UnmarshalJSON(data []byte) error {
+	return UnmarshalDictionary[K, V](data, list)
+}
+
+// MarshalJSON only encodes the pairs that have not expired, without their expiration
+func (dict *expiringHash[K, V]) MarshalJSON() ([]byte, error) {
+	return MarshalDictionary[K, V](dict)
+}
+
+// UnmarshalJSON saves the decoded pairs without expiration
+func (dict *expiringHash[K, V]) UnmarshalJSON(data []byte) error {
+	return UnmarshalDictionary[K, V](data, dict)
+}
+
+// MarshalJSON encodes every key with the array of its values, in the order they were added
+func (multi *multiHash[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalPairs(multi.index.Iterate)
+}
+
+func (multi *multiHash[K, V]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	pairs, err := unmarshalPairs[K, []V](data)
+	if err != nil {
+		return err
+	}
+
+	clearDictionary(multi.index.Iterate, func(key K) { multi.RemoveAll(key) })
+	for _, pair := range pairs {
+		for _, value := range pair.value {
+			multi.Add(pair.key, value)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes every element with its number of occurrences
+func (bag *hashBag[T]) MarshalJSON() ([]byte, error) {
+	return marshalPairs(bag.counts.Iterate)
+}
+
+func (bag *hashBag[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	pairs, err := unmarshalPairs[T, int](data)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		if pair.value <= 0 {
+			return fmt.Errorf("dictionary: the element %v occurs %d times", pair.key, pair.value)
+		}
+	}
+
+	bag.counts = CreateHash[T, int](bag.cmp).(*openHash[T, int])
+	bag.total = 0
+	for _, pair := range pairs {
+		bag.Add(pair.key, pair.value)
+	}
+	return nil
+}
+
+func (bimap *biMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalPairs(bimap.Iterate)
+}
+
+// UnmarshalJSON follows the ConflictPolicy of the BiMap for values that appear with more than one key, returning an
+// error instead of panicking for PanicOnConflict. The pairs are replaced in the hashes shared with the inverse view
+func (bimap *biMap[K, V]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	pairs, err := unmarshalPairs[K, V](data)
+	if err != nil {
+		return err
+	}
+	if bimap.policy == PanicOnConflict {
+		owners := CreateHash[V, K](bimap.valueCmp)
+		for _, pair := range pairs {
+			if owners.Belongs(pair.value) && !bimap.keyCmp(owners.Get(pair.value), pair.key) {
+				return fmt.Errorf("dictionary: the value %v belongs to more than one key", pair.value)
+			}
+			owners.Save(pair.value, pair.key)
+		}
+	}
+
+	clearDictionary(bimap.Iterate, func(key K) { bimap.DeleteByKey(key) })
+	for _, pair := range pairs {
+		bimap.Save(pair.key, pair.value)
+	}
+	return nil
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// hasStringKeys determines whether the keys are strings, which are written as the names of a JSON object. Their
+// underlying string is used, so that keys of named string types are written as they are
+func hasStringKeys[K any]() bool {
+	return reflect.TypeFor[K]().Kind() == reflect.String
+}
+
+func marshalPairs[K, V any](iterate func(func(K, V) bool)) ([]byte, error) {
+	stringKeys := hasStringKeys[K]()
+	opening, closing := byte('['), byte(']')
+	if stringKeys {
+		opening, closing = '{', '}'
+	}
+	var buffer bytes.Buffer
+	var err error
+	buffer.WriteByte(opening)
+
+	first := true
+	iterate(func(key K, value V) bool {
+		var keyData, valueData []byte
+		if stringKeys {
+			keyData, err = json.Marshal(reflect.ValueOf(key).String())
+		} else {
+			keyData, err = json.Marshal(key)
+		}
+		if err == nil {
+			valueData, err = json.Marshal(value)
+		}
+		if err != nil {
+			return false
+		}
+
+		if !first {
+			buffer.WriteByte(',')
+		}
+		first = false
+		if stringKeys {
+			buffer.Write(keyData)
+			buffer.WriteByte(':')
+			buffer.Write(valueData)
+		} else {
+			buffer.WriteByte('[')
+			buffer.Write(keyData)
+			buffer.WriteByte(',')
+			buffer.Write(valueData)
+			buffer.WriteByte(']')
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buffer.WriteByte(closing)
+	return buffer.Bytes(), nil
+}
+
+// unmarshalPairs decodes the pairs written by marshalPairs, in the order they appear
+func unmarshalPairs[K, V any](data []byte) ([]keyValuePair[K, V], error) {
+	pairs := []keyValuePair[K, V]{}
+	if !hasStringKeys[K]() {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		for _, element := range raw {
+			var pair []json.RawMessage
+			if err := json.Unmarshal(element, &pair); err != nil || len(pair) != 2 {
+				return nil, errPair
+			}
+			var key K
+			var value V
+			if err := json.Unmarshal(pair[0], &key); err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(pair[1], &value); err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, keyValuePair[K, V]{key, value})
+		}
+		return pairs, nil
+	}
+
+	// The object is read token by token to keep the order of its names
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil {
+		return nil, err
+	} else if token != json.Delim('{') {
+		return nil, fmt.Errorf("dictionary: expected a JSON object but found %v", token)
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var key K
+		reflect.ValueOf(&key).Elem().SetString(token.(string))
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, keyValuePair[K, V]{key, value})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// clearDictionary removes every key that iterate visits, once it has visited all of them
+func clearDictionary[K, V any](iterate func(func(K, V) bool), remove func(K)) {
+	var keys []K
+	iterate(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	for _, key := range keys {
+		remove(key)
+	}
+}
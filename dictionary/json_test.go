@@ -0,0 +1,196 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// word is a named string type, whose keys are written as the names of a JSON object as well
+type word string
+
+func TestJSONStringKeysAsObject(t *testing.T) {
+	t.Log("Dictionaries with string keys are written as objects, in the order they are iterated")
+	avl := TDADictionary.CreateAVL[string, int](strings.Compare)
+	avl.Save("Gato", 3)
+	avl.Save("Aguila", 1)
+	avl.Save("Perro", 2)
+	data, err := json.Marshal(avl)
+	require.NoError(t, err)
+	require.Equal(t, `{"Aguila":1,"Gato":3,"Perro":2}`, string(data))
+
+	hash := TDADictionary.CreateHash[word, []int](func(a, b word) bool { return a == b })
+	hash.Save("primos", []int{2, 3, 5})
+	hash.Save("pares", []int{2, 4})
+	data, err = json.Marshal(hash)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"primos":[2,3,5],"pares":[2,4]}`, string(data))
+
+	copied := TDADictionary.CreateHash[word, []int](func(a, b word) bool { return a == b })
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 2, copied.Count())
+	require.Equal(t, []int{2, 3, 5}, copied.Get("primos"))
+}
+
+func TestJSONNonStringKeysAsPairs(t *testing.T) {
+	t.Log("Dictionaries with other keys are written as arrays of [key, value] pairs")
+	tree := TDADictionary.CreateBTree[int, string](intCompare, 2)
+	for i, name := range []string{"cero", "uno", "dos", "tres"} {
+		tree.Save(i, name)
+	}
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	require.Equal(t, `[[0,"cero"],[1,"uno"],[2,"dos"],[3,"tres"]]`, string(data))
+
+	copied := TDADictionary.CreateSkipList[int, string](intCompare, 47)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 4, copied.Count())
+	require.EqualValues(t, "dos", copied.Get(2))
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Log("Every dictionary reads back what it writes, replacing the pairs it had")
+	dictionaries := map[string]func() TDADictionary.Dictionary[int, string]{
+		"Hash": func() TDADictionary.Dictionary[int, string] {
+			return TDADictionary.CreateHash[int, string](intEquality)
+		},
+		"AVL": func() TDADictionary.Dictionary[int, string] { return TDADictionary.CreateAVL[int, string](intCompare) },
+		"BTree": func() TDADictionary.Dictionary[int, string] {
+			return TDADictionary.CreateBTree[int, string](intCompare, 3)
+		},
+		"SkipList": func() TDADictionary.Dictionary[int, string] {
+			return TDADictionary.CreateSkipList[int, string](intCompare, 47)
+		},
+		"Expiring": func() TDADictionary.Dictionary[int, string] {
+			return TDADictionary.CreateExpiringHash[int, string](intEquality, time.Now, nil)
+		},
+	}
+	for name, create := range dictionaries {
+		dict := create()
+		for i := 0; i < 100; i++ {
+			dict.Save(i, strings.Repeat("x", i%7))
+		}
+		data, err := json.Marshal(dict)
+		require.NoError(t, err, name)
+
+		copied := create()
+		copied.Save(-1, "descartado")
+		require.NoError(t, json.Unmarshal(data, copied), name)
+		require.EqualValues(t, 100, copied.Count(), name)
+		require.False(t, copied.Belongs(-1), name)
+		dict.Iterate(func(key int, value string) bool {
+			require.EqualValues(t, value, copied.Get(key), name)
+			return true
+		})
+	}
+}
+
+func TestJSONNullAndInvalidInput(t *testing.T) {
+	t.Log("A null leaves the dictionary as it is, and invalid input returns an error without changing it")
+	dict := TDADictionary.CreateAVL[int, string](intCompare)
+	dict.Save(1, "uno")
+	require.NoError(t, json.Unmarshal([]byte("null"), dict))
+	for _, text := range []string{`{"1":"uno"}`, `[[1]]`, `[[1,"uno",2]]`, `[1,"uno"]`, `[["uno",1]]`, `[[1,"uno"]`} {
+		require.Error(t, json.Unmarshal([]byte(text), dict), text)
+	}
+	require.EqualValues(t, 1, dict.Count())
+	require.EqualValues(t, "uno", dict.Get(1))
+
+	named := TDADictionary.CreateHash[string, int](stringEquality)
+	for _, text := range []string{`[["A",1]]`, `{"A":"uno"}`, `{"A":1`} {
+		require.Error(t, json.Unmarshal([]byte(text), named), text)
+	}
+	require.EqualValues(t, 0, named.Count())
+}
+
+func TestJSONExpiringHashSkipsExpiredPairs(t *testing.T) {
+	t.Log("Only the pairs that have not expired are written, and they are read back without TTL")
+	clock := newFakeClock()
+	dict := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	dict.Save("A", 1)
+	dict.SaveWithTTL("B", 2, time.Minute)
+	dict.SaveWithTTL("C", 3, time.Hour)
+	clock.Advance(2 * time.Minute)
+	data, err := json.Marshal(dict)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"A":1,"C":3}`, string(data))
+
+	copied := TDADictionary.CreateExpiringHash[string, int](stringEquality, clock.Now, nil)
+	require.NoError(t, json.Unmarshal(data, copied))
+	clock.Advance(1000 * time.Hour)
+	require.EqualValues(t, 2, copied.Count())
+}
+
+func TestJSONMultiHash(t *testing.T) {
+	t.Log("Every key is written with the array of its values, in the order they were added")
+	multi := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	multi.Add("A", 3)
+	multi.Add("A", 1)
+	multi.Add("A", 3)
+	multi.Add("B", 2)
+	data, err := json.Marshal(multi)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"A":[3,1,3],"B":[2]}`, string(data))
+
+	copied := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	copied.Add("Z", 0)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 4, copied.Count())
+	require.False(t, copied.Belongs("Z"))
+	require.Equal(t, []int{3, 1, 3}, listToSlice(copied.GetAll("A")))
+	require.Error(t, json.Unmarshal([]byte(`{"A":3}`), copied))
+}
+
+func TestJSONBag(t *testing.T) {
+	t.Log("Every element of a bag is written with its number of occurrences")
+	bag := TDADictionary.CreateBag[int](intEquality)
+	bag.Add(7, 3)
+	bag.Add(5, 1)
+	data, err := json.Marshal(bag)
+	require.NoError(t, err)
+	var pairs [][2]int
+	require.NoError(t, json.Unmarshal(data, &pairs))
+	require.ElementsMatch(t, [][2]int{{7, 3}, {5, 1}}, pairs)
+
+	copied := TDADictionary.CreateBag[int](intEquality)
+	copied.Add(1, 10)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 4, copied.Total())
+	require.EqualValues(t, 2, copied.Distinct())
+	require.EqualValues(t, 3, copied.Count(7))
+	require.EqualValues(t, 0, copied.Count(1))
+
+	require.Error(t, json.Unmarshal([]byte(`[[7,0]]`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[[7,-2]]`), copied))
+	require.EqualValues(t, 4, copied.Total())
+}
+
+func TestJSONBiMap(t *testing.T) {
+	t.Log("A BiMap reads back its pairs following its ConflictPolicy, and its inverse view sees them")
+	bimap := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.PanicOnConflict)
+	bimap.Save("Bruno", 1)
+	bimap.Save("Abril", 2)
+	data, err := json.Marshal(bimap)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Bruno":1,"Abril":2}`, string(data))
+
+	copied := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.PanicOnConflict)
+	inverse := copied.Inverse()
+	copied.Save("Rocco", 9)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 2, inverse.Count())
+	require.EqualValues(t, "Abril", inverse.GetByKey(2))
+	require.False(t, inverse.BelongsKey(9))
+
+	require.Error(t, json.Unmarshal([]byte(`{"Bruno":1,"Rocco":1}`), copied))
+	require.EqualValues(t, "Bruno", copied.GetByValue(1))
+
+	overwriting := TDADictionary.CreateBiMap[string, int](stringEquality, intEquality, TDADictionary.OverwriteOnConflict)
+	require.NoError(t, json.Unmarshal([]byte(`{"Bruno":1,"Rocco":1}`), overwriting))
+	require.EqualValues(t, 1, overwriting.Count())
+	require.EqualValues(t, "Rocco", overwriting.GetByValue(1))
+}
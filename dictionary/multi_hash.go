@@ -0,0 +1,96 @@
+package dictionary
+
+import (
+	TDAList "adts/list"
+)
+
+const _PANIC_MESSAGE_VALUE = "The value is not associated with the key"
+
+type multiHash[K, V any] struct {
+	index    Dictionary[K, TDAList.List[V]]
+	count    int
+	valueCmp func(V, V) bool
+}
+
+// CreateMultiHash creates a MultiDictionary that keeps, in a hash, a list with the values of each key. keyCmp
+// compares keys as in CreateHash, and valueCmp tells RemoveValue which value to remove
+func CreateMultiHash[K, V any](keyCmp func(K, K) bool, valueCmp func(V, V) bool) MultiDictionary[K, V] {
+	return &multiHash[K, V]{index: CreateHash[K, TDAList.List[V]](keyCmp), valueCmp: valueCmp}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (multi *multiHash[K, V]) Add(key K, value V) {
+	var values TDAList.List[V]
+	if multi.index.Belongs(key) {
+		values = multi.index.Get(key)
+	} else {
+		values = TDAList.CreateLinkedList[V]()
+		multi.index.Save(key, values)
+	}
+
+	values.InsertLast(value)
+	multi.count++
+}
+
+func (multi *multiHash[K, V]) Belongs(key K) bool {
+	return multi.index.Belongs(key)
+}
+
+func (multi *multiHash[K, V]) GetAll(key K) TDAList.List[V] {
+	result := TDAList.CreateLinkedList[V]()
+	if !multi.index.Belongs(key) {
+		return result
+	}
+
+	multi.index.Get(key).Iterate(func(value V) bool {
+		result.InsertLast(value)
+		return true
+	})
+	return result
+}
+
+func (multi *multiHash[K, V]) RemoveValue(key K, value V) {
+	values := multi.index.Get(key)
+
+	for iter := values.Iterator(); iter.HasNext(); iter.Next() {
+		if multi.valueCmp(iter.Current(), value) {
+			iter.Remove()
+			multi.count--
+
+			if values.IsEmpty() {
+				multi.index.Delete(key)
+			}
+			return
+		}
+	}
+	panic(_PANIC_MESSAGE_VALUE)
+}
+
+func (multi *multiHash[K, V]) RemoveAll(key K) TDAList.List[V] {
+	values := multi.index.Delete(key)
+	multi.count -= values.Length()
+	return values
+}
+
+func (multi *multiHash[K, V]) CountKey(key K) int {
+	if !multi.index.Belongs(key) {
+		return 0
+	}
+	return multi.index.Get(key).Length()
+}
+
+func (multi *multiHash[K, V]) Count() int {
+	return multi.count
+}
+
+func (multi *multiHash[K, V]) Iterate(visit func(key K, value V) bool) {
+	multi.index.Iterate(func(key K, values TDAList.List[V]) bool {
+		keepIterating := true
+		values.Iterate(func(value V) bool {
+			keepIterating = visit(key, value)
+			return keepIterating
+		})
+		return keepIterating
+	})
+}
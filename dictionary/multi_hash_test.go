@@ -0,0 +1,133 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func listToSlice[T any](list TDAList.List[T]) []T {
+	result := []T{}
+	list.Iterate(func(element T) bool {
+		result = append(result, element)
+		return true
+	})
+	return result
+}
+
+func TestMultiHashEmpty(t *testing.T) {
+	t.Log("Check that an empty MultiDictionary has no keys nor values")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	require.EqualValues(t, 0, dict.Count())
+	require.EqualValues(t, 0, dict.CountKey("A"))
+	require.False(t, dict.Belongs("A"))
+	require.True(t, dict.GetAll("A").IsEmpty())
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.RemoveValue("A", 1) })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.RemoveAll("A") })
+}
+
+func TestMultiHashAddKeepsEveryValue(t *testing.T) {
+	t.Log("Adding to an existing key keeps the previous values, in insertion order")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	dict.Add("A", 1)
+	dict.Add("B", 10)
+	dict.Add("A", 2)
+	dict.Add("A", 1)
+
+	require.EqualValues(t, 4, dict.Count())
+	require.EqualValues(t, 3, dict.CountKey("A"))
+	require.EqualValues(t, 1, dict.CountKey("B"))
+	require.Equal(t, []int{1, 2, 1}, listToSlice(dict.GetAll("A")))
+	require.Equal(t, []int{10}, listToSlice(dict.GetAll("B")))
+}
+
+func TestMultiHashGetAllReturnsCopy(t *testing.T) {
+	t.Log("Modifying the list returned by GetAll does not change the dictionary")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	dict.Add("A", 1)
+
+	values := dict.GetAll("A")
+	values.InsertLast(2)
+	values.RemoveFirst()
+
+	require.EqualValues(t, 1, dict.Count())
+	require.Equal(t, []int{1}, listToSlice(dict.GetAll("A")))
+}
+
+func TestMultiHashRemoveValue(t *testing.T) {
+	t.Log("RemoveValue deletes a single occurrence, and the key once it has no values left")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	dict.Add("A", 1)
+	dict.Add("A", 2)
+	dict.Add("A", 1)
+
+	dict.RemoveValue("A", 1)
+	require.Equal(t, []int{2, 1}, listToSlice(dict.GetAll("A")))
+	require.EqualValues(t, 2, dict.Count())
+	require.PanicsWithValue(t, "The value is not associated with the key", func() { dict.RemoveValue("A", 3) })
+
+	dict.RemoveValue("A", 1)
+	dict.RemoveValue("A", 2)
+	require.False(t, dict.Belongs("A"))
+	require.EqualValues(t, 0, dict.Count())
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.RemoveValue("A", 2) })
+}
+
+func TestMultiHashRemoveAll(t *testing.T) {
+	t.Log("RemoveAll returns every value of the key and updates the total count")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	dict.Add("A", 1)
+	dict.Add("A", 2)
+	dict.Add("B", 3)
+
+	require.Equal(t, []int{1, 2}, listToSlice(dict.RemoveAll("A")))
+	require.False(t, dict.Belongs("A"))
+	require.EqualValues(t, 1, dict.Count())
+	require.True(t, dict.Belongs("B"))
+}
+
+func TestMultiHashIterate(t *testing.T) {
+	t.Log("The internal iterator visits every pair, and cuts when asked to")
+	dict := TDADictionary.CreateMultiHash[int, int](intEquality, intEquality)
+	for key := 0; key < 100; key++ {
+		for value := 0; value < 5; value++ {
+			dict.Add(key, key*10+value)
+		}
+	}
+
+	visited := 0
+	dict.Iterate(func(key int, value int) bool {
+		require.EqualValues(t, key, value/10)
+		visited++
+		return true
+	})
+	require.EqualValues(t, 500, visited)
+
+	visited = 0
+	dict.Iterate(func(_ int, _ int) bool {
+		visited++
+		return visited < 7
+	})
+	require.EqualValues(t, 7, visited)
+}
+
+func TestMultiHashVolume(t *testing.T) {
+	t.Log("Many values spread over many keys are stored and removed correctly")
+	dict := TDADictionary.CreateMultiHash[string, int](stringEquality, intEquality)
+	for i := 0; i < 10000; i++ {
+		dict.Add(fmt.Sprintf("%03d", i%500), i)
+	}
+	require.EqualValues(t, 10000, dict.Count())
+
+	for key := 0; key < 500; key++ {
+		require.EqualValues(t, 20, dict.CountKey(fmt.Sprintf("%03d", key)))
+		require.EqualValues(t, key, dict.GetAll(fmt.Sprintf("%03d", key)).PeekFirst())
+	}
+	for i := 0; i < 10000; i++ {
+		dict.RemoveValue(fmt.Sprintf("%03d", i%500), i)
+	}
+	require.EqualValues(t, 0, dict.Count())
+}
@@ -0,0 +1,426 @@
+package dictionary
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	_PANIC_MESSAGE_CLOSED   = "The dictionary is closed"
+	_PANIC_MESSAGE_INTERVAL = "The interval must be positive"
+	_PANIC_MESSAGE_ENCODE   = "The pair cannot be encoded"
+	_LOG_MAGIC              = "ADTSLOG"
+	_LOG_VERSION            = 1
+	_LOG_MAX_RECORD         = 1 << 30
+	_COMPACT_SUFFIX         = ".compact"
+)
+
+// The operations a record of the log can hold
+const (
+	_RECORD_SAVE byte = iota + 1
+	_RECORD_DELETE
+)
+
+// ErrNotLog is returned when opening a file that is not the log of a PersistentDictionary, or whose records cannot
+// be understood or are damaged before its end
+var ErrNotLog = errors.New("dictionary: the file is not a valid dictionary log")
+
+var (
+	errTornRecord    = errors.New("dictionary: incomplete record")
+	errDamagedRecord = errors.New("dictionary: damaged record")
+	crcTable         = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// persistentHash keeps its pairs in a hash and appends every change to a log, which is read back when it is opened
+// again. A record of the log is the length and the CRC-32C of its payload, four bytes each in little endian, and
+// then the payload: the operation, the length of the key as a uvarint, the key and, for a save, the value
+type persistentHash[K, V any] struct {
+	index  Dictionary[K, V]
+	codec  Codec[K, V]
+	path   string
+	policy SyncPolicy
+	closed bool
+
+	// mutex guards the log, which is also synced by the goroutine of SyncEvery
+	mutex sync.Mutex
+	file  *os.File
+	dirty bool
+	err   error
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// OpenPersistentHash opens the log at path, creating it if it does not exist, and replays it into a hash whose keys
+// are compared with cmp as in CreateHash. Then every Save and Delete is appended to the log, encoded with codec,
+// before it changes the hash, and the log is flushed to disk as policy says. If the last record of the log is
+// incomplete or does not match its checksum, as a crash in the middle of a write leaves it, it is discarded, but
+// if a damaged record is followed by others the log is left as it is and ErrNotLog is returned. As in the other
+// dictionaries, the primitives are not safe for concurrent use
+func OpenPersistentHash[K, V any](path string, cmp func(K, K) bool, codec Codec[K, V], policy SyncPolicy) (PersistentDictionary[K, V], error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	dict := &persistentHash[K, V]{index: CreateHash[K, V](cmp), codec: codec, path: path, policy: policy, file: file}
+	if err := dict.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if policy.interval > 0 {
+		dict.stop, dict.done = make(chan struct{}), make(chan struct{})
+		go dict.syncer(policy.interval, dict.stop, dict.done)
+	}
+	return dict, nil
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (dict *persistentHash[K, V]) Save(key K, value V) {
+	if dict.closed {
+		panic(_PANIC_MESSAGE_CLOSED)
+	}
+	dict.append(dict.mustEncode(_RECORD_SAVE, key, value))
+	dict.index.Save(key, value)
+}
+
+func (dict *persistentHash[K, V]) Belongs(key K) bool {
+	return dict.index.Belongs(key)
+}
+
+func (dict *persistentHash[K, V]) Get(key K) V {
+	return dict.index.Get(key)
+}
+
+func (dict *persistentHash[K, V]) Delete(key K) V {
+	if dict.closed {
+		panic(_PANIC_MESSAGE_CLOSED)
+	}
+	if !dict.index.Belongs(key) {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	var none V
+	dict.append(dict.mustEncode(_RECORD_DELETE, key, none))
+	return dict.index.Delete(key)
+}
+
+func (dict *persistentHash[K, V]) Count() int {
+	return dict.index.Count()
+}
+
+func (dict *persistentHash[K, V]) Iterate(visit func(key K, value V) bool) {
+	dict.index.Iterate(visit)
+}
+
+func (dict *persistentHash[K, V]) Iterator() DictionaryIterator[K, V] {
+	return dict.index.Iterator()
+}
+
+// -------------------- PERSISTENCE PRIMITIVES --------------------
+
+func (dict *persistentHash[K, V]) Compact() error {
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+
+	if dict.err != nil {
+		return dict.err
+	}
+	if dict.closed {
+		return os.ErrClosed
+	}
+
+	temporary := dict.path + _COMPACT_SUFFIX
+	file, err := os.OpenFile(temporary, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := dict.writeCompacted(file); err != nil {
+		file.Close()
+		os.Remove(temporary)
+		return err
+	}
+	if err := os.Rename(temporary, dict.path); err != nil {
+		file.Close()
+		os.Remove(temporary)
+		return err
+	}
+
+	// The new log is in place, so it is the one to append to even if the directory cannot be synced
+	dict.file.Close()
+	dict.file, dict.dirty = file, false
+	return syncDirectory(filepath.Dir(dict.path))
+}
+
+func (dict *persistentHash[K, V]) Sync() error {
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+
+	if dict.err != nil || dict.closed {
+		return dict.err
+	}
+	if err := dict.file.Sync(); err != nil {
+		dict.err = err
+		return err
+	}
+	dict.dirty = false
+	return nil
+}
+
+func (dict *persistentHash[K, V]) Err() error {
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+	return dict.err
+}
+
+func (dict *persistentHash[K, V]) Close() error {
+	if dict.closed {
+		return dict.Err()
+	}
+	dict.closed = true
+	if dict.stop != nil {
+		close(dict.stop)
+		<-dict.done
+	}
+
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+	syncErr := dict.file.Sync()
+	closeErr := dict.file.Close()
+	if dict.err == nil {
+		dict.err = errors.Join(syncErr, closeErr)
+	}
+	return dict.err
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// replay reads the log into the hash, truncating the last record if it is incomplete or damaged, and leaves the file
+// ready to append to it. An empty file is given the header of a new log
+func (dict *persistentHash[K, V]) replay() error {
+	info, err := dict.file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		if _, err := dict.file.Write(append([]byte(_LOG_MAGIC), _LOG_VERSION)); err != nil {
+			return err
+		}
+		return dict.file.Sync()
+	}
+
+	reader := bufio.NewReader(dict.file)
+	header := make([]byte, len(_LOG_MAGIC)+1)
+	if _, err := io.ReadFull(reader, header); err != nil || string(header[:len(_LOG_MAGIC)]) != _LOG_MAGIC {
+		return fmt.Errorf("%w: %s", ErrNotLog, dict.path)
+	}
+	if version := header[len(_LOG_MAGIC)]; version == 0 || version > _LOG_VERSION {
+		return fmt.Errorf("%w: %s has version %d", ErrNotLog, dict.path, version)
+	}
+
+	valid := int64(len(header))
+	for {
+		payload, size, err := readRecord(reader)
+		if err == io.EOF || err == errTornRecord {
+			break
+		}
+		if err == errDamagedRecord {
+			// A crash only damages the end of the log, so anything after the record means the file is corrupt
+			if valid+size < info.Size() {
+				return fmt.Errorf("%w: %s has a damaged record at offset %d", ErrNotLog, dict.path, valid)
+			}
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := dict.apply(payload); err != nil {
+			return fmt.Errorf("%w: %s at offset %d: %v", ErrNotLog, dict.path, valid, err)
+		}
+		valid += size
+	}
+
+	if valid < info.Size() {
+		if err := dict.file.Truncate(valid); err != nil {
+			return err
+		}
+	}
+	_, err = dict.file.Seek(valid, io.SeekStart)
+	return err
+}
+
+// readRecord returns the payload of the next record and its size in the log. It returns io.EOF at the end of the
+// log, errTornRecord if the record is incomplete, and errDamagedRecord with the size the record claims to have if
+// its length is not valid or it does not match its CRC
+func readRecord(reader *bufio.Reader) ([]byte, int64, error) {
+	var header [8]byte
+	if n, err := io.ReadFull(reader, header[:]); err != nil {
+		if n == 0 && err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, 0, errTornRecord
+		}
+		return nil, 0, err
+	}
+
+	length := binary.LittleEndian.Uint32(header[:4])
+	size := int64(len(header)) + int64(length)
+	if length == 0 || length > _LOG_MAX_RECORD {
+		return nil, size, errDamagedRecord
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, errTornRecord
+		}
+		return nil, 0, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:]) {
+		return nil, size, errDamagedRecord
+	}
+	return payload, size, nil
+}
+
+// apply decodes the payload of a record and changes the hash as it says
+func (dict *persistentHash[K, V]) apply(payload []byte) error {
+	operation := payload[0]
+	length, n := binary.Uvarint(payload[1:])
+	if n <= 0 || length > uint64(len(payload)-1-n) {
+		return errors.New("the length of the key is not valid")
+	}
+	keyData, valueData := payload[1+n:1+n+int(length)], payload[1+n+int(length):]
+
+	key, err := dict.codec.DecodeKey(keyData)
+	if err != nil {
+		return err
+	}
+	switch operation {
+	case _RECORD_SAVE:
+		value, err := dict.codec.DecodeValue(valueData)
+		if err != nil {
+			return err
+		}
+		dict.index.Save(key, value)
+	case _RECORD_DELETE:
+		if dict.index.Belongs(key) {
+			dict.index.Delete(key)
+		}
+	default:
+		return fmt.Errorf("unknown operation %d", operation)
+	}
+	return nil
+}
+
+// encode returns the record of the operation, ready to be written to the log
+func (dict *persistentHash[K, V]) encode(operation byte, key K, value V) ([]byte, error) {
+	keyData, err := dict.codec.EncodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var valueData []byte
+	if operation == _RECORD_SAVE {
+		if valueData, err = dict.codec.EncodeValue(value); err != nil {
+			return nil, err
+		}
+	}
+
+	record := make([]byte, 8, 8+1+binary.MaxVarintLen64+len(keyData)+len(valueData))
+	record = append(record, operation)
+	record = binary.AppendUvarint(record, uint64(len(keyData)))
+	record = append(record, keyData...)
+	record = append(record, valueData...)
+	if len(record)-8 > _LOG_MAX_RECORD {
+		return nil, fmt.Errorf("dictionary: the record of %d bytes is too large for the log", len(record)-8)
+	}
+	binary.LittleEndian.PutUint32(record[:4], uint32(len(record)-8))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(record[8:], crcTable))
+	return record, nil
+}
+
+// mustEncode returns the record of the operation, panicking if the codec cannot encode it, so that a change that
+// cannot be logged is refused before it touches the hash
+func (dict *persistentHash[K, V]) mustEncode(operation byte, key K, value V) []byte {
+	record, err := dict.encode(operation, key, value)
+	if err != nil {
+		panic(_PANIC_MESSAGE_ENCODE)
+	}
+	return record
+}
+
+// append writes the record to the log, unless an earlier write failed
+func (dict *persistentHash[K, V]) append(record []byte) {
+	dict.mutex.Lock()
+	defer dict.mutex.Unlock()
+
+	if dict.err != nil {
+		return
+	}
+	_, err := dict.file.Write(record)
+	if err == nil && dict.policy == SyncAlways {
+		err = dict.file.Sync()
+	}
+	dict.err = err
+	dict.dirty = err == nil && dict.policy != SyncAlways
+}
+
+// writeCompacted writes a new log with a save record for every pair of the hash and syncs it
+func (dict *persistentHash[K, V]) writeCompacted(file *os.File) error {
+	writer := bufio.NewWriter(file)
+	writer.WriteString(_LOG_MAGIC)
+	writer.WriteByte(_LOG_VERSION)
+
+	var err error
+	dict.index.Iterate(func(key K, value V) bool {
+		var record []byte
+		if record, err = dict.encode(_RECORD_SAVE, key, value); err == nil {
+			_, err = writer.Write(record)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// syncer flushes the log every interval if it changed, until stop is closed
+func (dict *persistentHash[K, V]) syncer(interval time.Duration, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			dict.mutex.Lock()
+			if dict.dirty && dict.err == nil {
+				dict.err = dict.file.Sync()
+				dict.dirty = false
+			}
+			dict.mutex.Unlock()
+		}
+	}
+}
+
+// syncDirectory flushes the entries of the directory, so that a file renamed into it stays there after a crash
+func syncDirectory(path string) error {
+	directory, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer directory.Close()
+	return directory.Sync()
+}
@@ -0,0 +1,57 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fillDisk makes every write to the log at path fail as if the disk were full, by putting /dev/full in place of the
+// descriptor the process holds for it
+func fillDisk(t *testing.T, path string) {
+	full, err := os.OpenFile("/dev/full", os.O_WRONLY, 0)
+	if err != nil {
+		t.Skip("/dev/full is not available")
+	}
+	defer full.Close()
+
+	target, err := filepath.EvalSymlinks(path)
+	require.NoError(t, err)
+	descriptors, err := os.ReadDir("/proc/self/fd")
+	require.NoError(t, err)
+	for _, descriptor := range descriptors {
+		if link, err := os.Readlink(filepath.Join("/proc/self/fd", descriptor.Name())); err == nil && link == target {
+			fd, err := strconv.Atoi(descriptor.Name())
+			require.NoError(t, err)
+			require.NoError(t, syscall.Dup3(int(full.Fd()), fd, 0))
+			return
+		}
+	}
+	t.Fatal("The log is not open")
+}
+
+func TestPersistentHashKeepsFirstError(t *testing.T) {
+	t.Log("Once the log cannot be written, the changes are kept in memory only and the error is reported")
+	path := filepath.Join(t.TempDir(), "log")
+	dict := openPersistent(t, path, TDADictionary.SyncNever)
+	dict.Save("A", 1)
+	fillDisk(t, path)
+	dict.Save("B", 2)
+	dict.Delete("A")
+	require.EqualValues(t, 1, dict.Count())
+	require.True(t, dict.Belongs("B"))
+	require.ErrorIs(t, dict.Err(), syscall.ENOSPC)
+	require.Equal(t, dict.Err(), dict.Sync())
+	require.Equal(t, dict.Err(), dict.Compact())
+	require.Equal(t, dict.Err(), dict.Close())
+
+	reopened := openPersistent(t, path, TDADictionary.SyncNever)
+	defer reopened.Close()
+	require.EqualValues(t, 1, reopened.Count())
+	require.EqualValues(t, 1, reopened.Get("A"))
+}
@@ -0,0 +1,252 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var SYNC_POLICIES = map[string]TDADictionary.SyncPolicy{
+	"Always": TDADictionary.SyncAlways,
+	"Every":  TDADictionary.SyncEvery(time.Millisecond),
+	"Never":  TDADictionary.SyncNever,
+}
+
+func openPersistent(t *testing.T, path string, policy TDADictionary.SyncPolicy) TDADictionary.PersistentDictionary[string, int] {
+	dict, err := TDADictionary.OpenPersistentHash[string, int](path, stringEquality, TDADictionary.GobCodec[string, int](), policy)
+	require.NoError(t, err)
+	return dict
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info.Size()
+}
+
+// failingCodec cannot encode the key "bad"
+type failingCodec struct {
+	TDADictionary.Codec[string, int]
+}
+
+func (codec failingCodec) EncodeKey(key string) ([]byte, error) {
+	if key == "bad" {
+		return nil, errors.New("the key cannot be encoded")
+	}
+	return codec.Codec.EncodeKey(key)
+}
+
+func TestPersistentHashBehavesAsDictionary(t *testing.T) {
+	t.Log("A persistent hash behaves as any other dictionary while it is open")
+	dict := openPersistent(t, filepath.Join(t.TempDir(), "log"), TDADictionary.SyncNever)
+	defer dict.Close()
+	require.EqualValues(t, 0, dict.Count())
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("A") })
+
+	dict.Save("A", 1)
+	dict.Save("B", 2)
+	dict.Save("A", 3)
+	require.EqualValues(t, 2, dict.Count())
+	require.EqualValues(t, 3, dict.Get("A"))
+	require.EqualValues(t, 2, dict.Delete("B"))
+	require.False(t, dict.Belongs("B"))
+
+	iter := dict.Iterator()
+	key, value := iter.Current()
+	require.Equal(t, "A", key)
+	require.EqualValues(t, 3, value)
+	iter.Next()
+	require.False(t, iter.HasNext())
+	require.NoError(t, dict.Err())
+}
+
+func TestPersistentHashSurvivesReopening(t *testing.T) {
+	t.Log("Every change is replayed from the log when it is opened again, whatever the SyncPolicy")
+	for name, policy := range SYNC_POLICIES {
+		path := filepath.Join(t.TempDir(), "log")
+		dict := openPersistent(t, path, policy)
+		for i := 0; i < 500; i++ {
+			dict.Save(fmt.Sprintf("key %d", i), i)
+		}
+		for i := 0; i < 500; i += 3 {
+			dict.Delete(fmt.Sprintf("key %d", i))
+		}
+		for i := 1; i < 500; i += 3 {
+			dict.Save(fmt.Sprintf("key %d", i), -i)
+		}
+		require.NoError(t, dict.Sync(), name)
+		require.NoError(t, dict.Close(), name)
+
+		reopened := openPersistent(t, path, policy)
+		require.Equal(t, dict.Count(), reopened.Count(), name)
+		dict.Iterate(func(key string, value int) bool {
+			require.EqualValues(t, value, reopened.Get(key), name)
+			return true
+		})
+		reopened.Save("new", 1)
+		require.NoError(t, reopened.Close(), name)
+		reopened = openPersistent(t, path, policy)
+		require.True(t, reopened.Belongs("new"), name)
+		require.NoError(t, reopened.Close(), name)
+	}
+}
+
+func TestPersistentHashDiscardsTornRecords(t *testing.T) {
+	t.Log("The last record of the log is discarded if a crash cut it short or damaged it")
+	path := filepath.Join(t.TempDir(), "log")
+	dict := openPersistent(t, path, TDADictionary.SyncAlways)
+	dict.Save("A", 1)
+	dict.Save("B", 2)
+	complete := fileSize(t, path)
+	dict.Save("C", 3)
+	require.NoError(t, dict.Close())
+
+	require.NoError(t, os.Truncate(path, fileSize(t, path)-2))
+	dict = openPersistent(t, path, TDADictionary.SyncAlways)
+	require.EqualValues(t, 2, dict.Count())
+	require.False(t, dict.Belongs("C"))
+	require.Equal(t, complete, fileSize(t, path), "The torn record is removed from the log")
+	dict.Save("D", 4)
+	require.NoError(t, dict.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	dict = openPersistent(t, path, TDADictionary.SyncAlways)
+	defer dict.Close()
+	require.True(t, dict.Belongs("A"))
+	require.True(t, dict.Belongs("B"))
+	require.False(t, dict.Belongs("D"), "The record does not match its checksum")
+	require.Equal(t, complete, fileSize(t, path))
+}
+
+func TestPersistentHashRejectsDamagedRecords(t *testing.T) {
+	t.Log("A damaged record followed by others is not what a crash leaves, so the log is rejected and left as it is")
+	path := filepath.Join(t.TempDir(), "log")
+	dict := openPersistent(t, path, TDADictionary.SyncAlways)
+	dict.Save("A", 1)
+	middle := fileSize(t, path)
+	dict.Save("B", 2)
+	dict.Save("C", 3)
+	require.NoError(t, dict.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[middle+10] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	_, err = TDADictionary.OpenPersistentHash[string, int](path, stringEquality, TDADictionary.GobCodec[string, int](), TDADictionary.SyncAlways)
+	require.ErrorIs(t, err, TDADictionary.ErrNotLog)
+	unchanged, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, data, unchanged)
+}
+
+func TestPersistentHashCompact(t *testing.T) {
+	t.Log("Compacting rewrites the log with the pairs in the dictionary only, and the log can grow again afterwards")
+	path := filepath.Join(t.TempDir(), "log")
+	dict := openPersistent(t, path, TDADictionary.SyncNever)
+	for round := 0; round < 50; round++ {
+		for i := 0; i < 20; i++ {
+			dict.Save(fmt.Sprintf("key %d", i), round)
+		}
+	}
+	dict.Delete("key 0")
+	before := fileSize(t, path)
+	require.NoError(t, dict.Compact())
+	after := fileSize(t, path)
+	require.Less(t, after*10, before)
+	require.NoFileExists(t, path+".compact")
+
+	dict.Save("key 0", 100)
+	require.Greater(t, fileSize(t, path), after)
+	require.NoError(t, dict.Close())
+	require.ErrorIs(t, dict.Compact(), os.ErrClosed)
+
+	reopened := openPersistent(t, path, TDADictionary.SyncNever)
+	defer reopened.Close()
+	require.EqualValues(t, 20, reopened.Count())
+	require.EqualValues(t, 100, reopened.Get("key 0"))
+	require.EqualValues(t, 49, reopened.Get("key 19"))
+}
+
+func TestPersistentHashJSONCodec(t *testing.T) {
+	t.Log("The codec is pluggable, and a log cannot be read with a codec that does not understand it")
+	type point struct{ X, Y int }
+	path := filepath.Join(t.TempDir(), "log")
+	dict, err := TDADictionary.OpenPersistentHash[int, point](path, intEquality, TDADictionary.JSONCodec[int, point](), TDADictionary.SyncAlways)
+	require.NoError(t, err)
+	dict.Save(1, point{2, 3})
+	dict.Save(7, point{})
+	require.NoError(t, dict.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `{"X":2,"Y":3}`)
+
+	reopened, err := TDADictionary.OpenPersistentHash[int, point](path, intEquality, TDADictionary.JSONCodec[int, point](), TDADictionary.SyncAlways)
+	require.NoError(t, err)
+	require.Equal(t, point{2, 3}, reopened.Get(1))
+	require.Equal(t, point{}, reopened.Get(7))
+	require.NoError(t, reopened.Close())
+
+	_, err = TDADictionary.OpenPersistentHash[int, point](path, intEquality, TDADictionary.GobCodec[int, point](), TDADictionary.SyncAlways)
+	require.ErrorIs(t, err, TDADictionary.ErrNotLog)
+}
+
+func TestPersistentHashRejectsOtherFiles(t *testing.T) {
+	t.Log("Opening a file that is not a log fails without changing it")
+	directory := t.TempDir()
+	path := filepath.Join(directory, "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("Buy milk\n"), 0o644))
+	_, err := TDADictionary.OpenPersistentHash[string, int](path, stringEquality, TDADictionary.GobCodec[string, int](), TDADictionary.SyncNever)
+	require.ErrorIs(t, err, TDADictionary.ErrNotLog)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "Buy milk\n", string(data))
+
+	_, err = TDADictionary.OpenPersistentHash[string, int](filepath.Join(directory, "missing", "log"), stringEquality, TDADictionary.GobCodec[string, int](), TDADictionary.SyncNever)
+	require.Error(t, err)
+}
+
+func TestPersistentHashRefusesPairsItCannotEncode(t *testing.T) {
+	t.Log("A pair the codec cannot encode is refused without changing the dictionary nor stopping the log")
+	path := filepath.Join(t.TempDir(), "log")
+	dict, err := TDADictionary.OpenPersistentHash[string, int](path, stringEquality, failingCodec{TDADictionary.GobCodec[string, int]()}, TDADictionary.SyncNever)
+	require.NoError(t, err)
+	dict.Save("A", 1)
+	require.PanicsWithValue(t, "The pair cannot be encoded", func() { dict.Save("bad", 2) })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("bad") })
+	dict.Save("B", 3)
+	require.EqualValues(t, 2, dict.Count())
+	require.False(t, dict.Belongs("bad"))
+	require.NoError(t, dict.Err())
+	require.NoError(t, dict.Sync())
+	require.NoError(t, dict.Close())
+
+	reopened := openPersistent(t, path, TDADictionary.SyncNever)
+	defer reopened.Close()
+	require.EqualValues(t, 2, reopened.Count())
+	require.EqualValues(t, 1, reopened.Get("A"))
+	require.EqualValues(t, 3, reopened.Get("B"))
+}
+
+func TestPersistentHashClosed(t *testing.T) {
+	t.Log("A closed dictionary can still be read, but not changed")
+	dict := openPersistent(t, filepath.Join(t.TempDir(), "log"), TDADictionary.SyncEvery(time.Millisecond))
+	dict.Save("A", 1)
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, dict.Close())
+	require.NoError(t, dict.Close())
+	require.EqualValues(t, 1, dict.Get("A"))
+	require.PanicsWithValue(t, "The dictionary is closed", func() { dict.Save("B", 2) })
+	require.PanicsWithValue(t, "The dictionary is closed", func() { dict.Delete("A") })
+	require.PanicsWithValue(t, "The interval must be positive", func() { TDADictionary.SyncEvery(0) })
+}
@@ -0,0 +1,229 @@
+package dictionary
+
+import (
+	"math/rand"
+)
+
+const (
+	_SKIP_LIST_MAX_LEVEL = 32
+)
+
+// skipListNode has one forward link per level it takes part in, next[0] being the following node in key order
+type skipListNode[K, V any] struct {
+	key   K
+	value V
+	next  []*skipListNode[K, V]
+}
+
+type skipList[K, V any] struct {
+	head  *skipListNode[K, V]
+	level int
+	count int
+	cmp   func(K, K) int
+	rng   *rand.Rand
+}
+
+type iterSkipList[K, V any] struct {
+	list    *skipList[K, V]
+	current *skipListNode[K, V]
+	to      *K
+}
+
+// CreateSkipList creates an ordered dictionary backed by a skip list, where cmp compares the keys as in CreateAVL.
+// Each node climbs one more level with probability 1/2, drawn from a generator seeded with seed, so the same
+// operations with the same seed always build the same list
+func CreateSkipList[K, V any](cmp func(K, K) int, seed int64) OrderedDictionary[K, V] {
+	return &skipList[K, V]{
+		head:  &skipListNode[K, V]{next: make([]*skipListNode[K, V], _SKIP_LIST_MAX_LEVEL)},
+		level: 1,
+		cmp:   cmp,
+		rng:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (list *skipList[K, V]) Save(key K, value V) {
+	var update [_SKIP_LIST_MAX_LEVEL]*skipListNode[K, V]
+	node := list.predecessors(key, &update).next[0]
+	if node != nil && list.cmp(node.key, key) == 0 {
+		node.value = value
+		return
+	}
+
+	level := list.randomLevel()
+	for ; list.level < level; list.level++ {
+		update[list.level] = list.head
+	}
+
+	node = &skipListNode[K, V]{key: key, value: value, next: make([]*skipListNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	list.count++
+}
+
+func (list *skipList[K, V]) Belongs(key K) bool {
+	return list.search(key) != nil
+}
+
+func (list *skipList[K, V]) Get(key K) V {
+	node := list.search(key)
+	if node == nil {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return node.value
+}
+
+func (list *skipList[K, V]) Delete(key K) V {
+	var update [_SKIP_LIST_MAX_LEVEL]*skipListNode[K, V]
+	node := list.predecessors(key, &update).next[0]
+	if node == nil || list.cmp(node.key, key) != 0 {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+
+	for i := range node.next {
+		update[i].next[i] = node.next[i]
+	}
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+	list.count--
+	return node.value
+}
+
+func (list *skipList[K, V]) Count() int {
+	return list.count
+}
+
+func (list *skipList[K, V]) Iterate(visit func(key K, value V) bool) {
+	list.IterateRange(nil, nil, visit)
+}
+
+func (list *skipList[K, V]) Iterator() DictionaryIterator[K, V] {
+	return list.IteratorRange(nil, nil)
+}
+
+func (list *skipList[K, V]) IterateRange(from *K, to *K, visit func(key K, value V) bool) {
+	for iter := list.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+		if !visit(iter.Current()) {
+			return
+		}
+	}
+}
+
+func (list *skipList[K, V]) IteratorRange(from *K, to *K) DictionaryIterator[K, V] {
+	first := list.head.next[0]
+	if from != nil {
+		first = list.lowerBound(*from).next[0]
+	}
+	return &iterSkipList[K, V]{list: list, current: first, to: to}
+}
+
+func (list *skipList[K, V]) Floor(key K) (K, V, bool) {
+	predecessor := list.lowerBound(key)
+	if next := predecessor.next[0]; next != nil && list.cmp(next.key, key) == 0 {
+		return next.pair()
+	}
+	if predecessor == list.head {
+		predecessor = nil
+	}
+	return predecessor.pair()
+}
+
+func (list *skipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return list.lowerBound(key).next[0].pair()
+}
+
+func (list *skipList[K, V]) Min() (K, V) {
+	first := list.head.next[0]
+	if first == nil {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	return first.key, first.value
+}
+
+func (list *skipList[K, V]) Max() (K, V) {
+	if list.count == 0 {
+		panic(_PANIC_MESSAGE_EMPTY)
+	}
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil {
+			current = current.next[i]
+		}
+	}
+	return current.key, current.value
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterSkipList[K, V]) HasNext() bool {
+	return iter.current != nil && (iter.to == nil || iter.list.cmp(iter.current.key, *iter.to) <= 0)
+}
+
+func (iter *iterSkipList[K, V]) Current() (K, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	return iter.current.key, iter.current.value
+}
+
+func (iter *iterSkipList[K, V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	iter.current = iter.current.next[0]
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// lowerBound returns the last node whose key is less than key, which is the head if there is none
+func (list *skipList[K, V]) lowerBound(key K) *skipListNode[K, V] {
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && list.cmp(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+	}
+	return current
+}
+
+// predecessors works as lowerBound, also storing in update the last node before key in every level in use
+func (list *skipList[K, V]) predecessors(key K, update *[_SKIP_LIST_MAX_LEVEL]*skipListNode[K, V]) *skipListNode[K, V] {
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && list.cmp(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+	return current
+}
+
+func (list *skipList[K, V]) search(key K) *skipListNode[K, V] {
+	node := list.lowerBound(key).next[0]
+	if node != nil && list.cmp(node.key, key) == 0 {
+		return node
+	}
+	return nil
+}
+
+func (list *skipList[K, V]) randomLevel() int {
+	level := 1
+	for level < _SKIP_LIST_MAX_LEVEL && list.rng.Intn(2) == 0 {
+		level++
+	}
+	return level
+}
+
+// pair returns the key and value of the node and true, or zero values and false if the node is nil
+func (node *skipListNode[K, V]) pair() (K, V, bool) {
+	if node == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return node.key, node.value, true
+}
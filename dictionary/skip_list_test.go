@@ -0,0 +1,185 @@
+package dictionary_test
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipListEmptyDictionary(t *testing.T) {
+	t.Log("Check that an empty skip list has no keys")
+	dict := TDADictionary.CreateSkipList[string, string](strings.Compare, 1)
+	require.EqualValues(t, 0, dict.Count())
+	require.False(t, dict.Belongs("A"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Get("A") })
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("A") })
+	_, _, found := dict.Floor("A")
+	require.False(t, found)
+	_, _, found = dict.Ceiling("A")
+	require.False(t, found)
+
+	iter := dict.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Current() })
+	require.PanicsWithValue(t, "The iterator has finished iterating", func() { iter.Next() })
+}
+
+func TestSkipListSaveReplaceAndDelete(t *testing.T) {
+	t.Log("Save, replace and delete some keys, checking that the skip list behaves appropriately")
+	dict := TDADictionary.CreateSkipList[string, string](strings.Compare, 1)
+	dict.Save("Cat", "meow")
+	dict.Save("Dog", "woof")
+	dict.Save("Cow", "moo")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "woof", dict.Get("Dog"))
+
+	dict.Save("Dog", "bark")
+	require.EqualValues(t, 3, dict.Count())
+	require.EqualValues(t, "bark", dict.Get("Dog"))
+
+	require.EqualValues(t, "meow", dict.Delete("Cat"))
+	require.False(t, dict.Belongs("Cat"))
+	require.PanicsWithValue(t, "The key does not belong to the dictionary", func() { dict.Delete("Cat") })
+	require.EqualValues(t, 2, dict.Count())
+	require.True(t, dict.Belongs("Cow"))
+	require.True(t, dict.Belongs("Dog"))
+}
+
+func TestSkipListRanges(t *testing.T) {
+	t.Log("Range iteration only visits the keys between both bounds, which may be open")
+	dict := TDADictionary.CreateSkipList[int, int](intCompare, 2)
+	for i := 95; i >= 0; i -= 5 {
+		dict.Save(i, i)
+	}
+
+	collect := func(from, to *int) ([]int, []int) {
+		var internal, external []int
+		dict.IterateRange(from, to, func(key int, _ int) bool {
+			internal = append(internal, key)
+			return true
+		})
+		for iter := dict.IteratorRange(from, to); iter.HasNext(); iter.Next() {
+			key, _ := iter.Current()
+			external = append(external, key)
+		}
+		return internal, external
+	}
+
+	from, to := 12, 31
+	internal, external := collect(&from, &to)
+	require.Equal(t, []int{15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	internal, external = collect(nil, &to)
+	require.Equal(t, []int{0, 5, 10, 15, 20, 25, 30}, internal)
+	require.Equal(t, internal, external)
+
+	from = 85
+	internal, external = collect(&from, nil)
+	require.Equal(t, []int{85, 90, 95}, internal)
+	require.Equal(t, internal, external)
+
+	from, to = 41, 44
+	internal, external = collect(&from, &to)
+	require.Empty(t, internal)
+	require.Empty(t, external)
+
+	visited := 0
+	dict.IterateRange(nil, nil, func(key int, _ int) bool {
+		visited++
+		return key < 20
+	})
+	require.EqualValues(t, 5, visited, "The internal iterator stops as soon as visit returns false")
+}
+
+func TestSkipListFloorAndCeiling(t *testing.T) {
+	t.Log("Floor and Ceiling find the closest keys on each side, or report that there is none")
+	dict := TDADictionary.CreateSkipList[int, string](intCompare, 3)
+	for i := 10; i <= 50; i += 10 {
+		dict.Save(i, fmt.Sprint(i))
+	}
+
+	key, value, found := dict.Floor(35)
+	require.True(t, found)
+	require.EqualValues(t, 30, key)
+	require.EqualValues(t, "30", value)
+	key, _, found = dict.Floor(40)
+	require.True(t, found)
+	require.EqualValues(t, 40, key)
+	_, _, found = dict.Floor(9)
+	require.False(t, found)
+
+	key, value, found = dict.Ceiling(35)
+	require.True(t, found)
+	require.EqualValues(t, 40, key)
+	require.EqualValues(t, "40", value)
+	key, _, found = dict.Ceiling(10)
+	require.True(t, found)
+	require.EqualValues(t, 10, key)
+	_, _, found = dict.Ceiling(51)
+	require.False(t, found)
+}
+
+func TestSkipListRandomOperations(t *testing.T) {
+	t.Log("Random operations are checked against a builtin map and the AVL, for several seeds")
+	for seed := int64(0); seed < 5; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		dict := TDADictionary.CreateSkipList[int, int](intCompare, seed)
+		avl := TDADictionary.CreateAVL[int, int](intCompare)
+		reference := map[int]int{}
+
+		for i := 0; i < 10000; i++ {
+			key := rng.Intn(2000)
+			if _, ok := reference[key]; ok && rng.Intn(2) == 0 {
+				require.EqualValues(t, reference[key], dict.Delete(key))
+				avl.Delete(key)
+				delete(reference, key)
+			} else {
+				dict.Save(key, i)
+				avl.Save(key, i)
+				reference[key] = i
+			}
+
+			probe := rng.Intn(2100) - 50
+			expectedKey, expectedValue, expectedFound := avl.Floor(probe)
+			key, value, found := dict.Floor(probe)
+			require.Equal(t, []any{expectedKey, expectedValue, expectedFound}, []any{key, value, found})
+			expectedKey, expectedValue, expectedFound = avl.Ceiling(probe)
+			key, value, found = dict.Ceiling(probe)
+			require.Equal(t, []any{expectedKey, expectedValue, expectedFound}, []any{key, value, found})
+		}
+
+		require.EqualValues(t, len(reference), dict.Count())
+		iter := avl.Iterator()
+		dict.Iterate(func(key int, value int) bool {
+			expectedKey, expectedValue := iter.Current()
+			require.EqualValues(t, expectedKey, key)
+			require.EqualValues(t, expectedValue, value)
+			iter.Next()
+			return true
+		})
+		require.False(t, iter.HasNext())
+	}
+}
+
+func BenchmarkSkipList(b *testing.B) {
+	b.Log("Skip list stress test, saving, getting and deleting different amounts of elements")
+	for _, n := range VOLUME_SIZES {
+		b.Run(fmt.Sprintf("Test %d elements", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dict := TDADictionary.CreateSkipList[string, int](strings.Compare, int64(i))
+				for j := 0; j < n; j++ {
+					dict.Save(fmt.Sprintf("%08d", j), j)
+				}
+				for j := 0; j < n; j++ {
+					require.EqualValues(b, j, dict.Delete(fmt.Sprintf("%08d", j)))
+				}
+				require.EqualValues(b, 0, dict.Count())
+			}
+		})
+	}
+}
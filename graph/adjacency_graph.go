@@ -0,0 +1,178 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+)
+
+const (
+	_PANIC_MESSAGE_VERTEX     = "The vertex does not belong to the graph"
+	_PANIC_MESSAGE_EDGE       = "The edge does not belong to the graph"
+	_PANIC_MESSAGE_UNWEIGHTED = "The graph is not weighted"
+)
+
+// adjacencyGraph maps every vertex to the dictionary of the vertices its edges reach, with their weights. An
+// undirected edge is stored in the dictionaries of both of its vertices.
+type adjacencyGraph[V any, W Weight] struct {
+	adjacency TDADictionary.Dictionary[V, TDADictionary.Dictionary[V, W]]
+	cmp       func(V, V) bool
+	directed  bool
+	weighted  bool
+	edges     int
+}
+
+// CreateGraph creates an empty Graph stored as adjacency dictionaries, where vertices are compared with cmp as in
+// CreateHash. The order of the lists it returns is not specified, but it is the same every time a graph is built
+// with the same operations.
+func CreateGraph[V any, W Weight](cmp func(V, V) bool, direction Direction, weighting Weighting) Graph[V, W] {
+	return &adjacencyGraph[V, W]{
+		adjacency: TDADictionary.CreateHash[V, TDADictionary.Dictionary[V, W]](cmp),
+		cmp:       cmp,
+		directed:  direction == Directed,
+		weighted:  weighting == Weighted,
+	}
+}
+
+// ----------------------- GRAPH PRIMITIVES -----------------------
+
+func (graph *adjacencyGraph[V, W]) IsDirected() bool {
+	return graph.directed
+}
+
+func (graph *adjacencyGraph[V, W]) IsWeighted() bool {
+	return graph.weighted
+}
+
+func (graph *adjacencyGraph[V, W]) AddVertex(v V) {
+	if !graph.adjacency.Belongs(v) {
+		graph.adjacency.Save(v, TDADictionary.CreateHash[V, W](graph.cmp))
+	}
+}
+
+func (graph *adjacencyGraph[V, W]) RemoveVertex(v V) {
+	neighbours := graph.neighbours(v)
+	if graph.directed {
+		graph.edges -= neighbours.Count()
+		graph.adjacency.Iterate(func(u V, others TDADictionary.Dictionary[V, W]) bool {
+			if !graph.cmp(u, v) && others.Belongs(v) {
+				others.Delete(v)
+				graph.edges--
+			}
+			return true
+		})
+	} else {
+		neighbours.Iterate(func(w V, _ W) bool {
+			if !graph.cmp(v, w) {
+				graph.adjacency.Get(w).Delete(v)
+			}
+			graph.edges--
+			return true
+		})
+	}
+	graph.adjacency.Delete(v)
+}
+
+func (graph *adjacencyGraph[V, W]) HasVertex(v V) bool {
+	return graph.adjacency.Belongs(v)
+}
+
+func (graph *adjacencyGraph[V, W]) SameVertex(a V, b V) bool {
+	return graph.cmp(a, b)
+}
+
+func (graph *adjacencyGraph[V, W]) AddEdge(from V, to V) {
+	graph.addEdge(from, to, 1)
+}
+
+func (graph *adjacencyGraph[V, W]) AddWeightedEdge(from V, to V, weight W) {
+	if !graph.weighted {
+		panic(_PANIC_MESSAGE_UNWEIGHTED)
+	}
+	graph.addEdge(from, to, weight)
+}
+
+func (graph *adjacencyGraph[V, W]) RemoveEdge(from V, to V) {
+	if !graph.HasEdge(from, to) {
+		panic(_PANIC_MESSAGE_EDGE)
+	}
+	graph.adjacency.Get(from).Delete(to)
+	if !graph.directed && !graph.cmp(from, to) {
+		graph.adjacency.Get(to).Delete(from)
+	}
+	graph.edges--
+}
+
+func (graph *adjacencyGraph[V, W]) HasEdge(from V, to V) bool {
+	return graph.adjacency.Belongs(from) && graph.adjacency.Get(from).Belongs(to)
+}
+
+func (graph *adjacencyGraph[V, W]) Weight(from V, to V) W {
+	if !graph.HasEdge(from, to) {
+		panic(_PANIC_MESSAGE_EDGE)
+	}
+	return graph.adjacency.Get(from).Get(to)
+}
+
+func (graph *adjacencyGraph[V, W]) Adjacent(v V) TDAList.List[V] {
+	adjacent := TDAList.CreateLinkedList[V]()
+	graph.neighbours(v).Iterate(func(w V, _ W) bool {
+		adjacent.InsertLast(w)
+		return true
+	})
+	return adjacent
+}
+
+func (graph *adjacencyGraph[V, W]) Vertices() TDAList.List[V] {
+	vertices := TDAList.CreateLinkedList[V]()
+	graph.adjacency.Iterate(func(v V, _ TDADictionary.Dictionary[V, W]) bool {
+		vertices.InsertLast(v)
+		return true
+	})
+	return vertices
+}
+
+func (graph *adjacencyGraph[V, W]) Edges() TDAList.List[Edge[V, W]] {
+	edges := TDAList.CreateLinkedList[Edge[V, W]]()
+
+	// An undirected edge is listed from the first of its vertices to be visited
+	visited := TDADictionary.CreateHash[V, struct{}](graph.cmp)
+	graph.adjacency.Iterate(func(v V, neighbours TDADictionary.Dictionary[V, W]) bool {
+		visited.Save(v, struct{}{})
+		neighbours.Iterate(func(w V, weight W) bool {
+			if graph.directed || graph.cmp(v, w) || !visited.Belongs(w) {
+				edges.InsertLast(Edge[V, W]{v, w, weight})
+			}
+			return true
+		})
+		return true
+	})
+	return edges
+}
+
+func (graph *adjacencyGraph[V, W]) VertexCount() int {
+	return graph.adjacency.Count()
+}
+
+func (graph *adjacencyGraph[V, W]) EdgeCount() int {
+	return graph.edges
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func (graph *adjacencyGraph[V, W]) neighbours(v V) TDADictionary.Dictionary[V, W] {
+	if !graph.adjacency.Belongs(v) {
+		panic(_PANIC_MESSAGE_VERTEX)
+	}
+	return graph.adjacency.Get(v)
+}
+
+func (graph *adjacencyGraph[V, W]) addEdge(from V, to V, weight W) {
+	fromNeighbours, toNeighbours := graph.neighbours(from), graph.neighbours(to)
+	if !fromNeighbours.Belongs(to) {
+		graph.edges++
+	}
+	fromNeighbours.Save(to, weight)
+	if !graph.directed {
+		toNeighbours.Save(from, weight)
+	}
+}
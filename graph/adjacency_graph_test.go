@@ -0,0 +1,240 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_MESSAGE_VERTEX     = "The vertex does not belong to the graph"
+	_PANIC_MESSAGE_EDGE       = "The edge does not belong to the graph"
+	_PANIC_MESSAGE_UNWEIGHTED = "The graph is not weighted"
+)
+
+func stringEquality(a, b string) bool {
+	return a == b
+}
+
+func intEquality(a, b int) bool {
+	return a == b
+}
+
+// sorted returns the elements of the list as a sorted slice, since graphs do not specify an order
+func sorted(list TDAList.List[string]) []string {
+	result := []string{}
+	list.Iterate(func(v string) bool {
+		result = append(result, v)
+		return true
+	})
+	sort.Strings(result)
+	return result
+}
+
+func edgeStrings[W TDAGraph.Weight](graph TDAGraph.Graph[string, W]) []string {
+	result := []string{}
+	graph.Edges().Iterate(func(edge TDAGraph.Edge[string, W]) bool {
+		result = append(result, fmt.Sprintf("%s-%s:%v", edge.From, edge.To, edge.Weight))
+		return true
+	})
+	sort.Strings(result)
+	return result
+}
+
+func createGraph(direction TDAGraph.Direction, weighting TDAGraph.Weighting, vertices ...string) TDAGraph.Graph[string, int] {
+	graph := TDAGraph.CreateGraph[string, int](stringEquality, direction, weighting)
+	for _, v := range vertices {
+		graph.AddVertex(v)
+	}
+	return graph
+}
+
+func TestEmptyGraph(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted)
+	require.True(t, graph.IsDirected())
+	require.True(t, graph.IsWeighted())
+	require.EqualValues(t, 0, graph.VertexCount())
+	require.EqualValues(t, 0, graph.EdgeCount())
+	require.False(t, graph.HasVertex("A"))
+	require.False(t, graph.HasEdge("A", "B"))
+	require.True(t, graph.Vertices().IsEmpty())
+	require.True(t, graph.Edges().IsEmpty())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { graph.Adjacent("A") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { graph.RemoveVertex("A") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { graph.AddEdge("A", "B") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_EDGE, func() { graph.RemoveEdge("A", "B") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_EDGE, func() { graph.Weight("A", "B") })
+}
+
+func TestDirectedGraph(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "A", "B", "C")
+	graph.AddVertex("A")
+	require.EqualValues(t, 3, graph.VertexCount(), "Adding a vertex twice does nothing")
+
+	graph.AddWeightedEdge("A", "B", 5)
+	graph.AddWeightedEdge("A", "C", 2)
+	graph.AddEdge("C", "A")
+	require.EqualValues(t, 3, graph.EdgeCount())
+	require.True(t, graph.HasEdge("A", "B"))
+	require.False(t, graph.HasEdge("B", "A"), "Directed edges go one way")
+	require.EqualValues(t, 5, graph.Weight("A", "B"))
+	require.EqualValues(t, 1, graph.Weight("C", "A"))
+	require.Equal(t, []string{"B", "C"}, sorted(graph.Adjacent("A")))
+	require.Empty(t, sorted(graph.Adjacent("B")))
+
+	graph.AddWeightedEdge("A", "B", 7)
+	require.EqualValues(t, 3, graph.EdgeCount(), "Adding an edge again replaces its weight")
+	require.EqualValues(t, 7, graph.Weight("A", "B"))
+	require.Equal(t, []string{"A-B:7", "A-C:2", "C-A:1"}, edgeStrings(graph))
+
+	graph.RemoveEdge("A", "C")
+	require.False(t, graph.HasEdge("A", "C"))
+	require.True(t, graph.HasEdge("C", "A"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_EDGE, func() { graph.RemoveEdge("A", "C") })
+	require.EqualValues(t, 2, graph.EdgeCount())
+}
+
+func TestUndirectedGraph(t *testing.T) {
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Weighted, "A", "B", "C")
+	require.False(t, graph.IsDirected())
+	graph.AddWeightedEdge("A", "B", 3)
+	graph.AddWeightedEdge("B", "C", 4)
+	graph.AddWeightedEdge("C", "C", 1)
+
+	require.EqualValues(t, 3, graph.EdgeCount())
+	require.True(t, graph.HasEdge("B", "A"), "Undirected edges go both ways")
+	require.EqualValues(t, 3, graph.Weight("B", "A"))
+	require.Equal(t, []string{"A", "C"}, sorted(graph.Adjacent("B")))
+	require.Equal(t, []string{"B", "C"}, sorted(graph.Adjacent("C")))
+	require.Len(t, edgeStrings(graph), 3, "Each undirected edge is listed once")
+
+	graph.RemoveEdge("B", "A")
+	require.False(t, graph.HasEdge("A", "B"))
+	require.EqualValues(t, 2, graph.EdgeCount())
+	graph.RemoveEdge("C", "C")
+	require.Equal(t, []string{"B"}, sorted(graph.Adjacent("C")))
+	require.EqualValues(t, 1, graph.EdgeCount())
+}
+
+func TestUnweightedGraph(t *testing.T) {
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted, "A", "B")
+	require.False(t, graph.IsWeighted())
+	graph.AddEdge("A", "B")
+	require.EqualValues(t, 1, graph.Weight("B", "A"), "Edges of unweighted graphs weigh 1")
+	require.PanicsWithValue(t, _PANIC_MESSAGE_UNWEIGHTED, func() { graph.AddWeightedEdge("A", "B", 2) })
+}
+
+func TestRemoveVertex(t *testing.T) {
+	for _, direction := range []TDAGraph.Direction{TDAGraph.Directed, TDAGraph.Undirected} {
+		graph := createGraph(direction, TDAGraph.Unweighted, "A", "B", "C", "D")
+		graph.AddEdge("A", "B")
+		graph.AddEdge("B", "C")
+		graph.AddEdge("C", "A")
+		graph.AddEdge("B", "B")
+		graph.AddEdge("C", "D")
+
+		graph.RemoveVertex("B")
+		require.False(t, graph.HasVertex("B"))
+		require.EqualValues(t, 3, graph.VertexCount())
+		require.EqualValues(t, 2, graph.EdgeCount(), "Every edge that touches the vertex is removed")
+		require.False(t, graph.HasEdge("A", "B"))
+		require.True(t, graph.HasEdge("C", "A"))
+		require.Equal(t, []string{"A", "C", "D"}, sorted(graph.Vertices()))
+		require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { graph.AddEdge("A", "B") })
+	}
+}
+
+func TestGraphVolume(t *testing.T) {
+	graph := TDAGraph.CreateGraph[int, float64](intEquality, TDAGraph.Undirected, TDAGraph.Weighted)
+	const n = 2000
+	for i := 0; i < n; i++ {
+		graph.AddVertex(i)
+	}
+	for i := 0; i < n; i++ {
+		graph.AddWeightedEdge(i, (i+1)%n, float64(i)/2)
+		graph.AddWeightedEdge(i, (i+7)%n, 1.5)
+	}
+	require.EqualValues(t, 2*n, graph.EdgeCount())
+	require.EqualValues(t, 2*n, graph.Edges().Length())
+	require.EqualValues(t, 4, graph.Adjacent(100).Length())
+	require.EqualValues(t, 50, graph.Weight(101, 100))
+
+	for i := 0; i < n; i += 2 {
+		graph.RemoveVertex(i)
+	}
+	require.EqualValues(t, n/2, graph.VertexCount())
+	require.EqualValues(t, 0, graph.EdgeCount(), "Odd vertices were only joined to even ones")
+}
+
+func TestGraphFormat(t *testing.T) {
+	directed := TDAGraph.CreateGraph[string, int](stringEquality, TDAGraph.Directed, TDAGraph.Weighted)
+	for _, v := range []string{"C", "A", "B", "D"} {
+		directed.AddVertex(v)
+	}
+	directed.AddWeightedEdge("B", "C", 1)
+	directed.AddWeightedEdge("A", "B", 3)
+	require.Equal(t, "digraph{vertices: [A B C D], edges: [A->B:3 B->C:1]}", fmt.Sprint(directed))
+	require.Equal(t, `graph.Graph[string, int]{Direction: Directed, Weighting: Weighted, Vertices: {"A", "B", "C", "D"}, `+
+		`Edges: {{"A", "B", 3}, {"B", "C", 1}}}`, fmt.Sprintf("%#v", directed))
+
+	undirected := TDAGraph.CreateGraph[int, int](intEquality, TDAGraph.Undirected, TDAGraph.Unweighted)
+	undirected.AddVertex(2)
+	undirected.AddVertex(1)
+	undirected.AddEdge(2, 1)
+	require.Equal(t, "graph{vertices: [1 2], edges: [1-2]}", fmt.Sprint(undirected))
+	require.Equal(t, "graph.Graph[int, int]{Direction: Undirected, Weighting: Unweighted, Vertices: {1, 2}, Edges: {{1, 2, 1}}}",
+		fmt.Sprintf("%#v", undirected))
+}
+
+func TestGraphBinary(t *testing.T) {
+	graph := TDAGraph.CreateGraph[int, float64](intEquality, TDAGraph.Undirected, TDAGraph.Weighted)
+	const n = 2000
+	for i := 0; i < n; i++ {
+		graph.AddVertex(i)
+	}
+	for i := 0; i < n; i += 2 {
+		graph.AddWeightedEdge(i, (i+1)%n, float64(i)/2)
+		graph.AddWeightedEdge(i, (i+7)%n, 1.5)
+	}
+	graph.AddWeightedEdge(5, 5, 2)
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(graph))
+	data := buffer.Bytes()
+
+	copied := TDAGraph.CreateGraph[int, float64](intEquality, TDAGraph.Directed, TDAGraph.Unweighted)
+	copied.AddVertex(-1)
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied))
+	require.EqualValues(t, n, copied.VertexCount())
+	require.False(t, copied.HasVertex(-1))
+	require.False(t, copied.IsDirected(), "The graph takes the kind of the snapshot")
+	require.True(t, copied.IsWeighted())
+	require.EqualValues(t, graph.EdgeCount(), copied.EdgeCount())
+	graph.Edges().Iterate(func(edge TDAGraph.Edge[int, float64]) bool {
+		require.Equal(t, edge.Weight, copied.Weight(edge.To, edge.From))
+		return true
+	})
+	require.EqualValues(t, 500, copied.Weight(1001, 1000))
+
+	unweighted := createGraph(TDAGraph.Directed, TDAGraph.Unweighted, "A", "B", "C")
+	unweighted.AddEdge("A", "B")
+	unweighted.AddEdge("B", "A")
+	binary, err := unweighted.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+	copiedUnweighted := createGraph(TDAGraph.Undirected, TDAGraph.Weighted)
+	require.NoError(t, copiedUnweighted.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary))
+	require.True(t, copiedUnweighted.IsDirected())
+	require.False(t, copiedUnweighted.IsWeighted())
+	require.Equal(t, []string{"A", "B", "C"}, sorted(copiedUnweighted.Vertices()))
+	require.Equal(t, []string{"A-B:1", "B-A:1"}, edgeStrings(copiedUnweighted))
+
+	require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(copiedUnweighted))
+	require.Error(t, copiedUnweighted.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary[:len(binary)-1]))
+	require.Equal(t, []string{"A-B:1", "B-A:1"}, edgeStrings(copiedUnweighted), "A failed read changes nothing")
+}
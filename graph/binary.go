@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"adts/snapshot"
+	"fmt"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "graph"
+
+// snapshotHeader is the first section of the snapshot of a graph, which tells what kind of graph it is
+type snapshotHeader struct {
+	Directed bool
+	Weighted bool
+}
+
+// ----------------------- BINARY PRIMITIVES -----------------------
+
+// WriteTo writes a binary snapshot of the graph in three sections: its kind, its vertices and its edges, encoded with
+// encoding/gob. The edges of undirected graphs are written once.
+func (graph *adjacencyGraph[V, W]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, 1, func(visit func(snapshotHeader) bool) {
+		visit(snapshotHeader{graph.directed, graph.weighted})
+	})
+	if err == nil {
+		err = snapshot.Write(writer, graph.VertexCount(), graph.Vertices().Iterate)
+	}
+	if err == nil {
+		err = snapshot.Write(writer, graph.edges, graph.Edges().Iterate)
+	}
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the graph with the one of a snapshot written by WriteTo, which may be of another kind, keeping
+// its cmp, and reads nothing past the end of the snapshot. If the snapshot cannot be read, or holds an edge between
+// vertices it does not hold, it returns an error without changing the graph.
+func (graph *adjacencyGraph[V, W]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	var headers []snapshotHeader
+	err = snapshot.Read(reader, func(header snapshotHeader) {
+		headers = append(headers, header)
+	})
+	if err == nil && len(headers) != 1 {
+		err = snapshot.ErrFormat
+	}
+	if err != nil {
+		return reader.BytesRead(), err
+	}
+	header := headers[0]
+
+	direction, weighting := Undirected, Unweighted
+	if header.Directed {
+		direction = Directed
+	}
+	if header.Weighted {
+		weighting = Weighted
+	}
+	read := CreateGraph[V, W](graph.cmp, direction, weighting).(*adjacencyGraph[V, W])
+	err = snapshot.Read(reader, read.AddVertex)
+
+	var edgeErr error
+	if err == nil {
+		err = snapshot.Read(reader, func(edge Edge[V, W]) {
+			if edgeErr != nil {
+				return
+			}
+			if !read.HasVertex(edge.From) || !read.HasVertex(edge.To) {
+				edgeErr = fmt.Errorf("graph: the edge from %v to %v joins vertices that are not in the snapshot", edge.From, edge.To)
+				return
+			}
+			if !read.weighted {
+				edge.Weight = 1
+			}
+			read.addEdge(edge.From, edge.To, edge.Weight)
+		})
+	}
+	if err == nil {
+		err = edgeErr
+	}
+	if err == nil {
+		*graph = *read
+	}
+	return reader.BytesRead(), err
+}
+
+func (graph *adjacencyGraph[V, W]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(graph)
+}
+
+func (graph *adjacencyGraph[V, W]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, graph)
+}
+
+func (graph *adjacencyGraph[V, W]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(graph)
+}
+
+func (graph *adjacencyGraph[V, W]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, graph)
+}
@@ -0,0 +1,182 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAStack "adts/stack"
+)
+
+// TarjanSCC returns the strongly connected components of a directed graph, each as a list of its vertices, with a
+// single depth first search that keeps the lowest discovery index every vertex can reach. The components are
+// listed in reverse topological order: no edge goes from a component to an earlier one. It panics with the message
+// 'The graph is not directed' if the graph is undirected.
+func TarjanSCC[V any, W Weight](graph Graph[V, W]) TDAList.List[TDAList.List[V]] {
+	checkDirected(graph)
+	components := TDAList.CreateLinkedList[TDAList.List[V]]()
+	indices := TDADictionary.CreateHash[V, int](graph.SameVertex)
+	lowLinks := TDADictionary.CreateHash[V, int](graph.SameVertex)
+	open := TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+	members := TDAStack.NewDynamicStack[V]()
+
+	discover := func(v V) {
+		indices.Save(v, indices.Count())
+		lowLinks.Save(v, indices.Get(v))
+		open.Save(v, struct{}{})
+		members.Push(v)
+	}
+
+	for roots := graph.Vertices().Iterator(); roots.HasNext(); roots.Next() {
+		if indices.Belongs(roots.Current()) {
+			continue
+		}
+
+		pending := TDAStack.NewDynamicStack[exploration[V]]()
+		discover(roots.Current())
+		pending.Push(explore(roots.Current(), graph.Adjacent))
+		for !pending.IsEmpty() {
+			top := pending.Top()
+			if top.neighbours.HasNext() {
+				w := top.neighbours.Current()
+				top.neighbours.Next()
+				if !indices.Belongs(w) {
+					discover(w)
+					pending.Push(explore(w, graph.Adjacent))
+				} else if open.Belongs(w) {
+					lowLinks.Save(top.vertex, min(lowLinks.Get(top.vertex), indices.Get(w)))
+				}
+				continue
+			}
+
+			pending.Pop()
+			v := top.vertex
+			if lowLinks.Get(v) == indices.Get(v) {
+				// v is the first vertex discovered in its component, whose members are above it in the stack
+				component := TDAList.CreateLinkedList[V]()
+				for {
+					w := members.Pop()
+					open.Delete(w)
+					component.InsertFirst(w)
+					if graph.SameVertex(w, v) {
+						break
+					}
+				}
+				components.InsertLast(component)
+			}
+			if !pending.IsEmpty() {
+				parent := pending.Top().vertex
+				lowLinks.Save(parent, min(lowLinks.Get(parent), lowLinks.Get(v)))
+			}
+		}
+	}
+	return components
+}
+
+// KosarajuSCC returns the strongly connected components of a directed graph, each as a list of its vertices, with a
+// depth first search of the graph followed by one of its transpose, that starts from the vertices in reverse
+// order of finishing the first. The components are listed in topological order: no edge goes from a component to
+// a later one. It panics with the message 'The graph is not directed' if the graph is undirected.
+func KosarajuSCC[V any, W Weight](graph Graph[V, W]) TDAList.List[TDAList.List[V]] {
+	checkDirected(graph)
+
+	finished := TDAList.CreateLinkedList[V]()
+	explored := TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+	graph.Vertices().Iterate(func(root V) bool {
+		postorder(root, graph.Adjacent, explored, finished.InsertFirst)
+		return true
+	})
+
+	predecessors := TDADictionary.CreateHash[V, TDAList.List[V]](graph.SameVertex)
+	graph.Vertices().Iterate(func(v V) bool {
+		predecessors.Save(v, TDAList.CreateLinkedList[V]())
+		return true
+	})
+	graph.Vertices().Iterate(func(v V) bool {
+		graph.Adjacent(v).Iterate(func(w V) bool {
+			predecessors.Get(w).InsertLast(v)
+			return true
+		})
+		return true
+	})
+
+	components := TDAList.CreateLinkedList[TDAList.List[V]]()
+	explored = TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+	finished.Iterate(func(root V) bool {
+		if !explored.Belongs(root) {
+			component := TDAList.CreateLinkedList[V]()
+			postorder(root, predecessors.Get, explored, component.InsertLast)
+			components.InsertLast(component)
+		}
+		return true
+	})
+	return components
+}
+
+// Condensation contracts every strongly connected component of a directed graph into a single vertex, returning the
+// resulting acyclic graph and the component of every vertex. The components are numbered from 0 in topological
+// order, and there is an edge between two of them if the graph has an edge between their vertices. If the graph is
+// weighted, so is the condensation, and its edges weigh the least of the edges they replace. It panics with the
+// message 'The graph is not directed' if the graph is undirected.
+func Condensation[V any, W Weight](graph Graph[V, W]) (Graph[int, W], TDADictionary.Dictionary[V, int]) {
+	weighting := Unweighted
+	if graph.IsWeighted() {
+		weighting = Weighted
+	}
+	dag := CreateGraph[int, W](func(a, b int) bool { return a == b }, Directed, weighting)
+	membership := TDADictionary.CreateHash[V, int](graph.SameVertex)
+
+	KosarajuSCC(graph).Iterate(func(component TDAList.List[V]) bool {
+		number := dag.VertexCount()
+		dag.AddVertex(number)
+		component.Iterate(func(v V) bool {
+			membership.Save(v, number)
+			return true
+		})
+		return true
+	})
+
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		from, to := membership.Get(edge.From), membership.Get(edge.To)
+		switch {
+		case from == to:
+		case !dag.HasEdge(from, to):
+			if graph.IsWeighted() {
+				dag.AddWeightedEdge(from, to, edge.Weight)
+			} else {
+				dag.AddEdge(from, to)
+			}
+		case edge.Weight < dag.Weight(from, to):
+			dag.AddWeightedEdge(from, to, edge.Weight)
+		}
+		return true
+	})
+	return dag, membership
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// postorder explores depth first the vertices reached from the root through the edges adjacent lists, skipping and
+// marking as explored those in explored, and calls finish with each of them once all its neighbours are explored
+func postorder[V any](root V, adjacent func(V) TDAList.List[V], explored TDADictionary.Dictionary[V, struct{}], finish func(V)) {
+	if explored.Belongs(root) {
+		return
+	}
+
+	pending := TDAStack.NewDynamicStack[exploration[V]]()
+	explored.Save(root, struct{}{})
+	pending.Push(explore(root, adjacent))
+	for !pending.IsEmpty() {
+		top := pending.Top()
+		if !top.neighbours.HasNext() {
+			pending.Pop()
+			finish(top.vertex)
+			continue
+		}
+
+		w := top.neighbours.Current()
+		top.neighbours.Next()
+		if !explored.Belongs(w) {
+			explored.Save(w, struct{}{})
+			pending.Push(explore(w, adjacent))
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type componentsAlgorithm func(TDAGraph.Graph[string, int]) TDAList.List[TDAList.List[string]]
+
+var SCC_ALGORITHMS = map[string]componentsAlgorithm{
+	"Tarjan":   TDAGraph.TarjanSCC[string, int],
+	"Kosaraju": TDAGraph.KosarajuSCC[string, int],
+}
+
+// components returns the vertices of every component sorted, in the order the algorithm lists the components
+func components(list TDAList.List[TDAList.List[string]]) [][]string {
+	result := [][]string{}
+	list.Iterate(func(component TDAList.List[string]) bool {
+		result = append(result, sorted(component))
+		return true
+	})
+	return result
+}
+
+// createComponentsGraph creates a directed graph with the components {A, B, C}, {D, E}, {F} and {G}, where
+// A->B->C->A, D<->E, C->D, E->F, B->F and G is isolated
+func createComponentsGraph() TDAGraph.Graph[string, int] {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "A", "B", "C", "D", "E", "F", "G")
+	graph.AddWeightedEdge("A", "B", 1)
+	graph.AddWeightedEdge("B", "C", 1)
+	graph.AddWeightedEdge("C", "A", 1)
+	graph.AddWeightedEdge("D", "E", 1)
+	graph.AddWeightedEdge("E", "D", 1)
+	graph.AddWeightedEdge("C", "D", 7)
+	graph.AddWeightedEdge("B", "D", 3)
+	graph.AddWeightedEdge("E", "F", 2)
+	graph.AddWeightedEdge("B", "F", 5)
+	return graph
+}
+
+func TestSCCUndirected(t *testing.T) {
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted, "A")
+	for _, algorithm := range SCC_ALGORITHMS {
+		require.PanicsWithValue(t, _PANIC_MESSAGE_UNDIRECTED, func() { algorithm(graph) })
+	}
+	require.PanicsWithValue(t, _PANIC_MESSAGE_UNDIRECTED, func() { TDAGraph.Condensation(graph) })
+}
+
+func TestSCCComponents(t *testing.T) {
+	graph := createComponentsGraph()
+	expected := [][]string{{"A", "B", "C"}, {"D", "E"}, {"F"}, {"G"}}
+	for name, algorithm := range SCC_ALGORITHMS {
+		found := components(algorithm(graph))
+		require.ElementsMatch(t, expected, found, name)
+
+		position := map[string]int{}
+		for i, component := range found {
+			for _, v := range component {
+				position[v] = i
+			}
+		}
+		if name == "Tarjan" {
+			require.Greater(t, position["A"], position["D"], "Tarjan lists the components in reverse topological order")
+			require.Greater(t, position["D"], position["F"])
+		} else {
+			require.Less(t, position["A"], position["D"], "Kosaraju lists the components in topological order")
+			require.Less(t, position["D"], position["F"])
+		}
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	dag, membership := TDAGraph.Condensation(createComponentsGraph())
+	require.True(t, dag.IsDirected())
+	require.True(t, dag.IsWeighted())
+	require.EqualValues(t, 4, dag.VertexCount())
+	require.EqualValues(t, 7, membership.Count())
+
+	abc, de, f := membership.Get("A"), membership.Get("D"), membership.Get("F")
+	require.EqualValues(t, abc, membership.Get("C"))
+	require.EqualValues(t, de, membership.Get("E"))
+	require.EqualValues(t, 3, dag.EdgeCount())
+	require.EqualValues(t, 3, dag.Weight(abc, de), "The lightest of the replaced edges")
+	require.EqualValues(t, 2, dag.Weight(de, f))
+	require.EqualValues(t, 5, dag.Weight(abc, f))
+	require.True(t, dag.Adjacent(membership.Get("G")).IsEmpty())
+
+	_, cycle := TDAGraph.TopologicalSort(dag)
+	require.True(t, cycle.IsEmpty())
+	dag.Edges().Iterate(func(edge TDAGraph.Edge[int, int]) bool {
+		require.Less(t, edge.From, edge.To, "The components are numbered in topological order")
+		return true
+	})
+}
+
+func TestSCCRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+	for round := 0; round < 30; round++ {
+		graph := createGraph(TDAGraph.Directed, TDAGraph.Unweighted)
+		n := 40
+		for v := 0; v < n; v++ {
+			graph.AddVertex(fmt.Sprint(v))
+		}
+		for i := 0; i < 60; i++ {
+			graph.AddEdge(fmt.Sprint(rng.Intn(n)), fmt.Sprint(rng.Intn(n)))
+		}
+
+		// Two vertices are in the same component when each reaches the other
+		reaches := map[string]map[string]bool{}
+		graph.Vertices().Iterate(func(v string) bool {
+			reaches[v] = map[string]bool{}
+			TDAGraph.BFS(graph, v, func(w string) bool {
+				reaches[v][w] = true
+				return true
+			})
+			return true
+		})
+		var expected [][]string
+		assigned := map[string]bool{}
+		graph.Vertices().Iterate(func(v string) bool {
+			if !assigned[v] {
+				var component []string
+				for w := range reaches[v] {
+					if reaches[w][v] {
+						component = append(component, w)
+						assigned[w] = true
+					}
+				}
+				sort.Strings(component)
+				expected = append(expected, component)
+			}
+			return true
+		})
+
+		tarjan := components(TDAGraph.TarjanSCC(graph))
+		kosaraju := components(TDAGraph.KosarajuSCC(graph))
+		require.ElementsMatch(t, expected, tarjan)
+		require.ElementsMatch(t, expected, kosaraju)
+
+		dag, membership := TDAGraph.Condensation(graph)
+		require.EqualValues(t, len(expected), dag.VertexCount())
+		graph.Edges().Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+			from, to := membership.Get(edge.From), membership.Get(edge.To)
+			require.LessOrEqual(t, from, to)
+			require.Equal(t, from != to, dag.HasEdge(from, to))
+			return true
+		})
+	}
+}
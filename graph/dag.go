@@ -0,0 +1,166 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAQueue "adts/queue"
+	TDAStack "adts/stack"
+)
+
+const _PANIC_MESSAGE_UNDIRECTED = "The graph is not directed"
+
+type visitState int
+
+const (
+	_IN_PROGRESS visitState = iota
+	_FINISHED
+)
+
+// exploration is a vertex in a depth first stack, together with the neighbours that are still to be explored
+type exploration[V any] struct {
+	vertex     V
+	neighbours TDAList.ListIterator[V]
+}
+
+func explore[V any](v V, adjacent func(V) TDAList.List[V]) exploration[V] {
+	return exploration[V]{v, adjacent(v).Iterator()}
+}
+
+// KahnTopologicalSort orders the vertices of a directed graph so that every edge goes from a vertex to a later one,
+// by repeatedly taking out the vertices that no remaining edge reaches. If the graph has a cycle there is no such
+// order: then order is empty and cycle holds the vertices of one cycle, in the direction of its edges and starting
+// from any of them. Otherwise cycle is empty. It panics with the message 'The graph is not directed' if the graph
+// is undirected.
+func KahnTopologicalSort[V any, W Weight](graph Graph[V, W]) (order TDAList.List[V], cycle TDAList.List[V]) {
+	checkDirected(graph)
+	inDegrees := TDADictionary.CreateHash[V, int](graph.SameVertex)
+	graph.Vertices().Iterate(func(v V) bool {
+		inDegrees.Save(v, 0)
+		return true
+	})
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		inDegrees.Save(edge.To, inDegrees.Get(edge.To)+1)
+		return true
+	})
+
+	ready := TDAQueue.NewLinkedQueue[V]()
+	inDegrees.Iterate(func(v V, degree int) bool {
+		if degree == 0 {
+			ready.Enqueue(v)
+		}
+		return true
+	})
+
+	order = TDAList.CreateLinkedList[V]()
+	for !ready.IsEmpty() {
+		v := ready.Dequeue()
+		order.InsertLast(v)
+		inDegrees.Delete(v)
+		graph.Adjacent(v).Iterate(func(w V) bool {
+			degree := inDegrees.Get(w) - 1
+			inDegrees.Save(w, degree)
+			if degree == 0 {
+				ready.Enqueue(w)
+			}
+			return true
+		})
+	}
+
+	if inDegrees.Count() > 0 {
+		return TDAList.CreateLinkedList[V](), remainingCycle(graph, inDegrees)
+	}
+	return order, TDAList.CreateLinkedList[V]()
+}
+
+// TopologicalSort orders the vertices of a directed graph so that every edge goes from a vertex to a later one, by
+// listing them in reverse order of the moment a depth first search finishes exploring them. If the search finds an
+// edge back to a vertex it is still exploring, the graph has a cycle and there is no such order: then order is empty
+// and cycle holds the vertices of that cycle, in the direction of its edges and starting from any of them.
+// Otherwise cycle is empty. It panics with the message 'The graph is not directed' if the graph is undirected.
+func TopologicalSort[V any, W Weight](graph Graph[V, W]) (order TDAList.List[V], cycle TDAList.List[V]) {
+	checkDirected(graph)
+	order = TDAList.CreateLinkedList[V]()
+	states := TDADictionary.CreateHash[V, visitState](graph.SameVertex)
+	parents := TDADictionary.CreateHash[V, V](graph.SameVertex)
+
+	for roots := graph.Vertices().Iterator(); roots.HasNext(); roots.Next() {
+		if states.Belongs(roots.Current()) {
+			continue
+		}
+
+		pending := TDAStack.NewDynamicStack[exploration[V]]()
+		states.Save(roots.Current(), _IN_PROGRESS)
+		pending.Push(explore(roots.Current(), graph.Adjacent))
+		for !pending.IsEmpty() {
+			top := pending.Top()
+			if !top.neighbours.HasNext() {
+				pending.Pop()
+				states.Save(top.vertex, _FINISHED)
+				order.InsertFirst(top.vertex)
+				continue
+			}
+
+			w := top.neighbours.Current()
+			top.neighbours.Next()
+			if !states.Belongs(w) {
+				states.Save(w, _IN_PROGRESS)
+				parents.Save(w, top.vertex)
+				pending.Push(explore(w, graph.Adjacent))
+			} else if states.Get(w) == _IN_PROGRESS {
+				// The vertices in progress are the path from the root to the top, so w is on it
+				return TDAList.CreateLinkedList[V](), treeCycle(graph, parents, top.vertex, w)
+			}
+		}
+	}
+	return order, TDAList.CreateLinkedList[V]()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func checkDirected[V any, W Weight](graph Graph[V, W]) {
+	if !graph.IsDirected() {
+		panic(_PANIC_MESSAGE_UNDIRECTED)
+	}
+}
+
+// treeCycle returns the cycle closed by the edge from v to its ancestor w, following the parents from v up to w
+func treeCycle[V any, W Weight](graph Graph[V, W], parents TDADictionary.Dictionary[V, V], v V, w V) TDAList.List[V] {
+	cycle := TDAList.CreateLinkedList[V]()
+	cycle.InsertFirst(v)
+	for !graph.SameVertex(v, w) {
+		v = parents.Get(v)
+		cycle.InsertFirst(v)
+	}
+	return cycle
+}
+
+// remainingCycle returns a cycle among the vertices Kahn's algorithm could not take out. Each of them is reached by
+// an edge from another one, so walking those edges backwards from any of them must eventually repeat a vertex.
+func remainingCycle[V any, W Weight](graph Graph[V, W], remaining TDADictionary.Dictionary[V, int]) TDAList.List[V] {
+	predecessors := TDADictionary.CreateHash[V, V](graph.SameVertex)
+	remaining.Iterate(func(v V, _ int) bool {
+		graph.Adjacent(v).Iterate(func(w V) bool {
+			if remaining.Belongs(w) {
+				predecessors.Save(w, v)
+			}
+			return true
+		})
+		return true
+	})
+
+	iter := remaining.Iterator()
+	v, _ := iter.Current()
+	walked := TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+	for !walked.Belongs(v) {
+		walked.Save(v, struct{}{})
+		v = predecessors.Get(v)
+	}
+
+	// v is on the cycle, which is walked backwards once more inserting at the front to follow the edges
+	cycle := TDAList.CreateLinkedList[V]()
+	cycle.InsertFirst(v)
+	for w := predecessors.Get(v); !graph.SameVertex(w, v); w = predecessors.Get(w) {
+		cycle.InsertFirst(w)
+	}
+	return cycle
+}
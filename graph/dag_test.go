@@ -0,0 +1,142 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _PANIC_MESSAGE_UNDIRECTED = "The graph is not directed"
+
+type topologicalSort func(TDAGraph.Graph[string, int]) (TDAList.List[string], TDAList.List[string])
+
+var TOPOLOGICAL_SORTS = map[string]topologicalSort{
+	"Kahn": TDAGraph.KahnTopologicalSort[string, int],
+	"DFS":  TDAGraph.TopologicalSort[string, int],
+}
+
+// createBuildGraph creates the dependencies of a small build, where every edge goes from a target to one that needs it
+func createBuildGraph() TDAGraph.Graph[string, int] {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Unweighted, "lexer", "parser", "ast", "checker", "codegen", "linker", "docs")
+	graph.AddEdge("lexer", "parser")
+	graph.AddEdge("ast", "parser")
+	graph.AddEdge("parser", "checker")
+	graph.AddEdge("ast", "checker")
+	graph.AddEdge("checker", "codegen")
+	graph.AddEdge("codegen", "linker")
+	graph.AddEdge("parser", "docs")
+	return graph
+}
+
+// requireTopological checks that the order has every vertex of the graph once, and that every edge goes forward
+func requireTopological(t *testing.T, graph TDAGraph.Graph[string, int], order TDAList.List[string]) {
+	positions := map[string]int{}
+	for i, v := range toSlice(order) {
+		require.NotContains(t, positions, v)
+		positions[v] = i
+	}
+	require.Len(t, positions, graph.VertexCount())
+	graph.Edges().Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.Less(t, positions[edge.From], positions[edge.To], "%s -> %s goes backwards", edge.From, edge.To)
+		return true
+	})
+}
+
+// requireCycle checks that the vertices are different and that each has an edge to the next, and the last to the first
+func requireCycle(t *testing.T, graph TDAGraph.Graph[string, int], cycle TDAList.List[string]) {
+	vertices := toSlice(cycle)
+	require.NotEmpty(t, vertices)
+	seen := map[string]bool{}
+	for i, v := range vertices {
+		require.False(t, seen[v], "%s is repeated in the cycle", v)
+		seen[v] = true
+		next := vertices[(i+1)%len(vertices)]
+		require.True(t, graph.HasEdge(v, next), "%s -> %s is not an edge", v, next)
+	}
+}
+
+func TestTopologicalSortEmptyGraph(t *testing.T) {
+	for name, sort := range TOPOLOGICAL_SORTS {
+		order, cycle := sort(createGraph(TDAGraph.Directed, TDAGraph.Unweighted))
+		require.True(t, order.IsEmpty(), name)
+		require.True(t, cycle.IsEmpty(), name)
+	}
+}
+
+func TestTopologicalSortUndirected(t *testing.T) {
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted, "A")
+	for _, sort := range TOPOLOGICAL_SORTS {
+		require.PanicsWithValue(t, _PANIC_MESSAGE_UNDIRECTED, func() { sort(graph) })
+	}
+}
+
+func TestTopologicalSortBuildOrder(t *testing.T) {
+	graph := createBuildGraph()
+	for name, sort := range TOPOLOGICAL_SORTS {
+		order, cycle := sort(graph)
+		require.True(t, cycle.IsEmpty(), name)
+		requireTopological(t, graph, order)
+	}
+}
+
+func TestTopologicalSortReportsCycle(t *testing.T) {
+	graph := createBuildGraph()
+	graph.AddEdge("linker", "parser")
+	for name, sort := range TOPOLOGICAL_SORTS {
+		order, cycle := sort(graph)
+		require.True(t, order.IsEmpty(), name)
+		require.ElementsMatch(t, []string{"parser", "checker", "codegen", "linker"}, toSlice(cycle), name)
+		requireCycle(t, graph, cycle)
+	}
+
+	graph = createGraph(TDAGraph.Directed, TDAGraph.Unweighted, "A", "B")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("B", "B")
+	for name, sort := range TOPOLOGICAL_SORTS {
+		_, cycle := sort(graph)
+		require.Equal(t, []string{"B"}, toSlice(cycle), name)
+	}
+}
+
+func TestTopologicalSortRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(43))
+	for round := 0; round < 50; round++ {
+		// The edges of a random permutation only go forward, until a backward one may be added to close a cycle
+		vertices := rng.Perm(60)
+		graph := createGraph(TDAGraph.Directed, TDAGraph.Unweighted)
+		for _, v := range vertices {
+			graph.AddVertex(fmt.Sprint(v))
+		}
+		for i := 0; i < 150; i++ {
+			from, to := rng.Intn(len(vertices)), rng.Intn(len(vertices))
+			if from < to {
+				graph.AddEdge(fmt.Sprint(vertices[from]), fmt.Sprint(vertices[to]))
+			}
+		}
+		acyclic := round%2 == 0
+		if !acyclic {
+			from := rng.Intn(len(vertices)-1) + 1
+			graph.AddEdge(fmt.Sprint(vertices[from]), fmt.Sprint(vertices[rng.Intn(from)]))
+		}
+
+		cyclic := map[string]bool{}
+		for name, sort := range TOPOLOGICAL_SORTS {
+			order, cycle := sort(graph)
+			cyclic[name] = !cycle.IsEmpty()
+			if cycle.IsEmpty() {
+				requireTopological(t, graph, order)
+			} else {
+				require.True(t, order.IsEmpty(), name)
+				requireCycle(t, graph, cycle)
+			}
+			if acyclic {
+				require.True(t, cycle.IsEmpty(), name)
+			}
+		}
+		require.Equal(t, cyclic["Kahn"], cyclic["DFS"], "Both sorts agree on whether there is a cycle")
+	}
+}
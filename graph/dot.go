@@ -0,0 +1,393 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// WriteDOT writes the graph in the DOT language of Graphviz, naming every vertex with name. The vertices and then
+// the edges are written sorted by those names, so that the same graph is always written the same way. The edges
+// of weighted graphs are labelled with their weight, and the graph is marked with the attribute weighted=true.
+func WriteDOT[V any, W Weight](w io.Writer, graph Graph[V, W], name func(V) string) error {
+	named := nameGraph(graph, name)
+	keyword, operator := "graph", "--"
+	if named.directed {
+		keyword, operator = "digraph", "->"
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%s {\n", keyword)
+	if named.weighted {
+		fmt.Fprintf(out, "\tgraph [weighted=true];\n")
+	}
+	for _, v := range named.vertices {
+		fmt.Fprintf(out, "\t%s;\n", quoteDOT(v))
+	}
+	for _, edge := range named.edges {
+		fmt.Fprintf(out, "\t%s %s %s", quoteDOT(edge.From), operator, quoteDOT(edge.To))
+		if named.weighted {
+			fmt.Fprintf(out, " [label=%s]", quoteDOT(formatWeight(edge.Weight)))
+		}
+		fmt.Fprintf(out, ";\n")
+	}
+	fmt.Fprintf(out, "}\n")
+	return out.Flush()
+}
+
+// ReadDOT reads a graph in the DOT language, as WriteDOT writes it, parsing the name of every vertex with parse
+// and telling vertices apart with cmp. The graph is directed if it is a digraph, and weighted if it has the
+// attribute weighted=true, in which case the label of every edge must be its weight. Node and edge statements,
+// with any number of edges chained, and attribute statements are understood, while subgraphs and ports are not.
+func ReadDOT[V any, W Weight](r io.Reader, cmp func(V, V) bool, parse func(string) (V, error)) (Graph[V, W], error) {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := tokenizeDOT(string(input))
+	if err != nil {
+		return nil, err
+	}
+	parser := &dotParser[V, W]{tokens: tokens, cmp: cmp, parse: parse}
+	if err := parser.parseGraph(); err != nil {
+		return nil, err
+	}
+	return parser.builder.graph, nil
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// quoteDOT quotes the text as a DOT string, escaping quotes and backslashes
+func quoteDOT(text string) string {
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	for _, char := range text {
+		if char == '"' || char == '\\' {
+			quoted.WriteByte('\\')
+		}
+		quoted.WriteRune(char)
+	}
+	quoted.WriteByte('"')
+	return quoted.String()
+}
+
+// dotToken is a word, a number, a quoted string or a symbol of a DOT text, with the line it starts on
+type dotToken struct {
+	text   string
+	quoted bool
+	line   int
+}
+
+// is determines whether the token is the symbol or the keyword, which DOT reads regardless of case
+func (token dotToken) is(text string) bool {
+	return !token.quoted && strings.EqualFold(token.text, text)
+}
+
+func tokenizeDOT(input string) ([]dotToken, error) {
+	var tokens []dotToken
+	chars := []rune(input)
+	line := 1
+	for i := 0; i < len(chars); {
+		char := chars[i]
+		switch {
+		case char == '\n':
+			line++
+			i++
+		case unicode.IsSpace(char):
+			i++
+		case char == '#' && (i == 0 || chars[i-1] == '\n'):
+			for i < len(chars) && chars[i] != '\n' {
+				i++
+			}
+		case char == '/' && i+1 < len(chars) && chars[i+1] == '/':
+			for i < len(chars) && chars[i] != '\n' {
+				i++
+			}
+		case char == '/' && i+1 < len(chars) && chars[i+1] == '*':
+			start := line
+			for i += 2; i+1 < len(chars) && !(chars[i] == '*' && chars[i+1] == '/'); i++ {
+				if chars[i] == '\n' {
+					line++
+				}
+			}
+			if i+1 >= len(chars) {
+				return nil, fmt.Errorf("dot: line %d: unterminated comment", start)
+			}
+			i += 2
+		case char == '"':
+			start := line
+			var text strings.Builder
+			for i++; i < len(chars) && chars[i] != '"'; i++ {
+				if chars[i] == '\n' {
+					line++
+				}
+				if chars[i] == '\\' && i+1 < len(chars) {
+					i++
+					if chars[i] == '\n' {
+						// A backslash before a newline only splits a long string
+						line++
+						continue
+					}
+				}
+				text.WriteRune(chars[i])
+			}
+			if i >= len(chars) {
+				return nil, fmt.Errorf("dot: line %d: unterminated string", start)
+			}
+			tokens = append(tokens, dotToken{text.String(), true, start})
+			i++
+		case char == '-' && i+1 < len(chars) && (chars[i+1] == '>' || chars[i+1] == '-'):
+			tokens = append(tokens, dotToken{string(chars[i : i+2]), false, line})
+			i += 2
+		case strings.ContainsRune("{}[];,=:", char):
+			tokens = append(tokens, dotToken{string(char), false, line})
+			i++
+		case isDOTWordChar(char) || char == '-':
+			start := i
+			for i++; i < len(chars) && isDOTWordChar(chars[i]); i++ {
+			}
+			tokens = append(tokens, dotToken{string(chars[start:i]), false, line})
+		default:
+			return nil, fmt.Errorf("dot: line %d: unexpected character %q", line, char)
+		}
+	}
+	return tokens, nil
+}
+
+func isDOTSymbol(text string) bool {
+	switch text {
+	case "{", "}", "[", "]", ";", ",", "=", ":", "->", "--":
+		return true
+	}
+	return false
+}
+
+func isDOTWordChar(char rune) bool {
+	return char == '_' || char == '.' || unicode.IsLetter(char) || unicode.IsDigit(char) || char >= 0x80
+}
+
+// dotParser reads the statements of a DOT graph from its tokens. The edges are kept until the end, since the
+// graph may be marked as weighted after some of them.
+type dotParser[V any, W Weight] struct {
+	tokens   []dotToken
+	position int
+	cmp      func(V, V) bool
+	parse    func(string) (V, error)
+	directed bool
+	weighted bool
+	vertices []string
+	edges    []dotEdge
+	builder  *graphBuilder[V, W]
+}
+
+// dotEdge is an edge as it was read, with the label that holds its weight, if any
+type dotEdge struct {
+	from  string
+	to    string
+	label *string
+	line  int
+}
+
+func (parser *dotParser[V, W]) peek() (dotToken, bool) {
+	if parser.position >= len(parser.tokens) {
+		return dotToken{}, false
+	}
+	return parser.tokens[parser.position], true
+}
+
+func (parser *dotParser[V, W]) next() (dotToken, error) {
+	token, ok := parser.peek()
+	if !ok {
+		return token, fmt.Errorf("dot: unexpected end of input")
+	}
+	parser.position++
+	return token, nil
+}
+
+func (parser *dotParser[V, W]) accept(text string) bool {
+	if token, ok := parser.peek(); ok && token.is(text) {
+		parser.position++
+		return true
+	}
+	return false
+}
+
+func (parser *dotParser[V, W]) expect(text string) error {
+	token, err := parser.next()
+	if err != nil {
+		return err
+	}
+	if !token.is(text) {
+		return fmt.Errorf("dot: line %d: expected %q but found %q", token.line, text, token.text)
+	}
+	return nil
+}
+
+// identifier reads a name, which is any word, number or quoted string but a symbol
+func (parser *dotParser[V, W]) identifier() (dotToken, error) {
+	token, err := parser.next()
+	if err != nil {
+		return token, err
+	}
+	if !token.quoted && isDOTSymbol(token.text) {
+		return token, fmt.Errorf("dot: line %d: expected a name but found %q", token.line, token.text)
+	}
+	return token, nil
+}
+
+func (parser *dotParser[V, W]) parseGraph() error {
+	parser.accept("strict")
+	if parser.accept("digraph") {
+		parser.directed = true
+	} else if err := parser.expect("graph"); err != nil {
+		return err
+	}
+	if token, ok := parser.peek(); ok && !token.is("{") {
+		if _, err := parser.identifier(); err != nil {
+			return err
+		}
+	}
+	if err := parser.expect("{"); err != nil {
+		return err
+	}
+
+	for !parser.accept("}") {
+		if err := parser.parseStatement(); err != nil {
+			return err
+		}
+		parser.accept(";")
+	}
+	if token, ok := parser.peek(); ok {
+		return fmt.Errorf("dot: line %d: unexpected %q after the graph", token.line, token.text)
+	}
+	return parser.build()
+}
+
+func (parser *dotParser[V, W]) parseStatement() error {
+	token, err := parser.identifier()
+	if err != nil {
+		return err
+	}
+
+	if token.is("graph") || token.is("node") || token.is("edge") {
+		attributes, err := parser.parseAttributes()
+		if err != nil {
+			return err
+		}
+		if token.is("graph") {
+			parser.setGraphAttributes(attributes)
+		}
+		return nil
+	}
+	if token.is("subgraph") {
+		return fmt.Errorf("dot: line %d: subgraphs are not supported", token.line)
+	}
+	if parser.accept("=") {
+		value, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		parser.setGraphAttributes(map[string]string{token.text: value.text})
+		return nil
+	}
+	if next, ok := parser.peek(); ok && next.is(":") {
+		return fmt.Errorf("dot: line %d: ports are not supported", next.line)
+	}
+
+	// A node statement, or the first node of a chain of edges
+	chain := []dotToken{token}
+	for {
+		operator, ok := parser.peek()
+		if !ok || !(operator.is("->") || operator.is("--")) {
+			break
+		}
+		if operator.is("->") != parser.directed {
+			return fmt.Errorf("dot: line %d: %q does not match the kind of graph", operator.line, operator.text)
+		}
+		parser.position++
+		if next, ok := parser.peek(); ok && next.is("{") {
+			return fmt.Errorf("dot: line %d: subgraphs are not supported", next.line)
+		}
+		to, err := parser.identifier()
+		if err != nil {
+			return err
+		}
+		chain = append(chain, to)
+	}
+	attributes, err := parser.parseAttributes()
+	if err != nil {
+		return err
+	}
+
+	if len(chain) == 1 {
+		parser.vertices = append(parser.vertices, token.text)
+		return nil
+	}
+	var label *string
+	if text, ok := attributes["label"]; ok {
+		label = &text
+	}
+	for i := 1; i < len(chain); i++ {
+		parser.edges = append(parser.edges, dotEdge{chain[i-1].text, chain[i].text, label, chain[i].line})
+	}
+	return nil
+}
+
+// parseAttributes reads any number of bracketed lists of attributes, if there are, separated by commas or
+// semicolons, where every attribute without a value is true
+func (parser *dotParser[V, W]) parseAttributes() (map[string]string, error) {
+	attributes := map[string]string{}
+	for parser.accept("[") {
+		for !parser.accept("]") {
+			key, err := parser.identifier()
+			if err != nil {
+				return nil, err
+			}
+			attributes[key.text] = "true"
+			if parser.accept("=") {
+				value, err := parser.identifier()
+				if err != nil {
+					return nil, err
+				}
+				attributes[key.text] = value.text
+			}
+			if !parser.accept(",") {
+				parser.accept(";")
+			}
+		}
+	}
+	return attributes, nil
+}
+
+func (parser *dotParser[V, W]) setGraphAttributes(attributes map[string]string) {
+	if value, ok := attributes["weighted"]; ok {
+		parser.weighted = strings.EqualFold(value, "true")
+	}
+}
+
+func (parser *dotParser[V, W]) build() error {
+	parser.builder = newGraphBuilder[V, W](parser.cmp, parser.parse, parser.directed, parser.weighted)
+	for _, v := range parser.vertices {
+		if _, err := parser.builder.addVertex(v); err != nil {
+			return fmt.Errorf("dot: %w", err)
+		}
+	}
+	for _, edge := range parser.edges {
+		var weight W
+		if parser.weighted {
+			if edge.label == nil {
+				return fmt.Errorf("dot: line %d: the edge %q %q has no weight", edge.line, edge.from, edge.to)
+			}
+			var err error
+			if weight, err = parseWeight[W](*edge.label); err != nil {
+				return fmt.Errorf("dot: line %d: %w", edge.line, err)
+			}
+		}
+		if err := parser.builder.addEdge(edge.from, edge.to, weight); err != nil {
+			return fmt.Errorf("dot: line %d: %w", edge.line, err)
+		}
+	}
+	return nil
+}
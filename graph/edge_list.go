@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// WriteEdgeList writes the graph as plain text, naming every vertex with name. The first line tells the kind of
+// graph, as in "directed weighted" or "undirected unweighted". Then comes a line for every edge, with the names of
+// its vertices and its weight if the graph is weighted, and a line with the name of every vertex without edges,
+// all separated by spaces and sorted by those names. Names that have spaces, quotes or nothing at all are quoted
+// as in Go.
+func WriteEdgeList[V any, W Weight](w io.Writer, graph Graph[V, W], name func(V) string) error {
+	named := nameGraph(graph, name)
+	direction, weighting := "undirected", "unweighted"
+	if named.directed {
+		direction = "directed"
+	}
+	if named.weighted {
+		weighting = "weighted"
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%s %s\n", direction, weighting)
+	for _, edge := range named.edges {
+		fmt.Fprintf(out, "%s %s", quoteField(edge.From), quoteField(edge.To))
+		if named.weighted {
+			fmt.Fprintf(out, " %s", formatWeight(edge.Weight))
+		}
+		fmt.Fprintf(out, "\n")
+	}
+	for _, v := range named.isolated() {
+		fmt.Fprintf(out, "%s\n", quoteField(v))
+	}
+	return out.Flush()
+}
+
+// ReadEdgeList reads a graph written by WriteEdgeList, parsing the name of every vertex with parse and telling
+// vertices apart with cmp. Blank lines and lines that start with # are skipped.
+func ReadEdgeList[V any, W Weight](r io.Reader, cmp func(V, V) bool, parse func(string) (V, error)) (Graph[V, W], error) {
+	scanner := bufio.NewScanner(r)
+	var builder *graphBuilder[V, W]
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields, err := splitFields(text)
+		if err != nil {
+			return nil, fmt.Errorf("edge list: line %d: %w", line, err)
+		}
+
+		if builder == nil {
+			if len(fields) != 2 || !oneOf(fields[0], "directed", "undirected") || !oneOf(fields[1], "weighted", "unweighted") {
+				return nil, fmt.Errorf("edge list: line %d: expected the kind of graph but found %q", line, text)
+			}
+			builder = newGraphBuilder[V, W](cmp, parse, fields[0] == "directed", fields[1] == "weighted")
+			continue
+		}
+
+		expected := 2
+		if builder.graph.IsWeighted() {
+			expected = 3
+		}
+		switch len(fields) {
+		case 1:
+			_, err = builder.addVertex(fields[0])
+		case expected:
+			var weight W
+			if builder.graph.IsWeighted() {
+				if weight, err = parseWeight[W](fields[2]); err != nil {
+					break
+				}
+			}
+			err = builder.addEdge(fields[0], fields[1], weight)
+		default:
+			err = fmt.Errorf("expected 1 or %d fields but found %d", expected, len(fields))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("edge list: line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if builder == nil {
+		return nil, fmt.Errorf("edge list: expected the kind of graph but found nothing")
+	}
+	return builder.graph, nil
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func oneOf(text string, options ...string) bool {
+	for _, option := range options {
+		if text == option {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteField quotes the name only if it could not be read back as a single field otherwise
+func quoteField(name string) string {
+	if name == "" || strings.HasPrefix(name, "#") || strings.ContainsFunc(name, func(char rune) bool {
+		return unicode.IsSpace(char) || char == '"' || !unicode.IsPrint(char)
+	}) {
+		return strconv.Quote(name)
+	}
+	return name
+}
+
+// splitFields splits the line in fields separated by spaces, where a field that starts with a quote is a Go
+// quoted string
+func splitFields(line string) ([]string, error) {
+	var fields []string
+	for line = strings.TrimLeftFunc(line, unicode.IsSpace); line != ""; line = strings.TrimLeftFunc(line, unicode.IsSpace) {
+		if line[0] != '"' {
+			end := strings.IndexFunc(line, unicode.IsSpace)
+			if end == -1 {
+				end = len(line)
+			}
+			fields, line = append(fields, line[:end]), line[end:]
+			continue
+		}
+
+		quoted, err := strconv.QuotedPrefix(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted name %s", line)
+		}
+		field, _ := strconv.Unquote(quoted)
+		fields, line = append(fields, field), line[len(quoted):]
+	}
+	return fields, nil
+}
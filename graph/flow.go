@@ -0,0 +1,292 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAQueue "adts/queue"
+)
+
+const _PANIC_MESSAGE_TERMINALS = "The source and the sink must be different"
+
+// Flow is a maximum flow from a source to a sink, where the weight of every edge is its capacity, the most that
+// can flow through it. The edges of undirected graphs can carry flow in any of their directions.
+type Flow[V any, W Weight] interface {
+
+	// Value returns the amount that flows out of the source and into the sink.
+	Value() W
+
+	// EdgeFlow returns the amount that flows through the edge in the direction from one vertex to the other. If
+	// the edge does not belong to the graph, it must panic with the message 'The edge does not belong to the graph'.
+	EdgeFlow(from V, to V) W
+
+	// Flows returns a new list with the edges that carry flow, in the direction it goes and weighing the amount.
+	Flows() TDAList.List[Edge[V, W]]
+
+	// MinCut returns a minimum cut of the graph, whose capacity is the value of the flow: a new list with the
+	// vertices on the side of the source and a new list with the edges that go from them to the other side.
+	MinCut() (TDAList.List[V], TDAList.List[Edge[V, W]])
+}
+
+// arc is an edge of the residual network. Every arc comes with its reverse, whose flow is always the opposite, so
+// that sending flow back through the reverse undoes it.
+type arc[W Weight] struct {
+	to       int
+	capacity W
+	flow     W
+	reverse  int
+}
+
+// flowNetwork is the residual network of a graph, with its vertices numbered and the arcs of each vertex listed
+// in adjacency. The arcs that stand for the edges of the graph are found in edgeArcs by their numbered endpoints.
+type flowNetwork[V any, W Weight] struct {
+	graph     Graph[V, W]
+	vertices  []V
+	indices   TDADictionary.Dictionary[V, int]
+	arcs      []arc[W]
+	adjacency [][]int
+	edgeArcs  TDADictionary.Dictionary[[2]int, int]
+	source    int
+	sink      int
+	value     W
+}
+
+// EdmondsKarp finds a maximum flow from the source to the sink by repeatedly sending flow through the path of the
+// residual network with the least edges, which it finds with a breadth first search. It panics with the message
+// 'The graph has negative weights' if an edge has a negative capacity, with 'The source and the sink must be
+// different' if they are the same vertex, and with 'The vertex does not belong to the graph' if any of them
+// does not belong to the graph.
+func EdmondsKarp[V any, W Weight](graph Graph[V, W], source V, sink V) Flow[V, W] {
+	network := newFlowNetwork(graph, source, sink)
+	parents := make([]int, len(network.vertices))
+	for network.augmentingPath(parents) {
+		bottleneck := network.residual(parents[network.sink])
+		for v := network.sink; v != network.source; v = network.arcs[network.arcs[parents[v]].reverse].to {
+			bottleneck = min(bottleneck, network.residual(parents[v]))
+		}
+		for v := network.sink; v != network.source; v = network.arcs[network.arcs[parents[v]].reverse].to {
+			network.push(parents[v], bottleneck)
+		}
+		network.value += bottleneck
+	}
+	return network
+}
+
+// Dinic finds a maximum flow from the source to the sink in phases, each of which numbers the vertices by their
+// distance from the source in the residual network and then saturates all the shortest paths that go one level
+// further on every arc. It panics as EdmondsKarp does.
+func Dinic[V any, W Weight](graph Graph[V, W], source V, sink V) Flow[V, W] {
+	network := newFlowNetwork(graph, source, sink)
+	levels := make([]int, len(network.vertices))
+	next := make([]int, len(network.vertices))
+	for network.level(levels) {
+		for i := range next {
+			next[i] = 0
+		}
+		for {
+			sent, ok := network.blockingPush(network.source, levels, next, nil)
+			if !ok {
+				break
+			}
+			network.value += sent
+		}
+	}
+	return network
+}
+
+// ----------------------- FLOW PRIMITIVES -----------------------
+
+func (network *flowNetwork[V, W]) Value() W {
+	return network.value
+}
+
+func (network *flowNetwork[V, W]) EdgeFlow(from V, to V) W {
+	if !network.graph.HasEdge(from, to) {
+		panic(_PANIC_MESSAGE_EDGE)
+	}
+
+	endpoints := [2]int{network.indices.Get(from), network.indices.Get(to)}
+	flow := network.arcs[network.edgeArcs.Get(endpoints)].flow
+	if !network.graph.IsDirected() && endpoints[0] != endpoints[1] {
+		// Flow through both arcs of an undirected edge cancels out, so only the difference goes anywhere. They
+		// are compared before subtracting, since the difference would wrap around for unsigned weights
+		opposite := network.arcs[network.edgeArcs.Get([2]int{endpoints[1], endpoints[0]})].flow
+		if flow <= opposite {
+			var zero W
+			return zero
+		}
+		flow -= opposite
+	}
+	return flow
+}
+
+func (network *flowNetwork[V, W]) Flows() TDAList.List[Edge[V, W]] {
+	flows := TDAList.CreateLinkedList[Edge[V, W]]()
+	var zero W
+	for _, edge := range directedEdges(network.graph) {
+		if flow := network.EdgeFlow(edge.From, edge.To); flow > zero {
+			flows.InsertLast(Edge[V, W]{edge.From, edge.To, flow})
+		}
+	}
+	return flows
+}
+
+func (network *flowNetwork[V, W]) MinCut() (TDAList.List[V], TDAList.List[Edge[V, W]]) {
+	// The source side is what the source still reaches in the residual network, and no arc leaving it has room
+	reached := make([]bool, len(network.vertices))
+	reached[network.source] = true
+	pending := TDAQueue.NewLinkedQueue[int]()
+	pending.Enqueue(network.source)
+	for !pending.IsEmpty() {
+		v := pending.Dequeue()
+		for _, id := range network.adjacency[v] {
+			if w := network.arcs[id].to; !reached[w] && network.hasRoom(id) {
+				reached[w] = true
+				pending.Enqueue(w)
+			}
+		}
+	}
+
+	side := TDAList.CreateLinkedList[V]()
+	for i, v := range network.vertices {
+		if reached[i] {
+			side.InsertLast(v)
+		}
+	}
+	cut := TDAList.CreateLinkedList[Edge[V, W]]()
+	for _, edge := range directedEdges(network.graph) {
+		if reached[network.indices.Get(edge.From)] && !reached[network.indices.Get(edge.To)] {
+			cut.InsertLast(edge)
+		}
+	}
+	return side, cut
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func newFlowNetwork[V any, W Weight](graph Graph[V, W], source V, sink V) *flowNetwork[V, W] {
+	if !graph.HasVertex(source) || !graph.HasVertex(sink) {
+		panic(_PANIC_MESSAGE_VERTEX)
+	}
+	if graph.SameVertex(source, sink) {
+		panic(_PANIC_MESSAGE_TERMINALS)
+	}
+
+	network := &flowNetwork[V, W]{
+		graph:    graph,
+		indices:  TDADictionary.CreateHash[V, int](graph.SameVertex),
+		edgeArcs: TDADictionary.CreateHash[[2]int, int](func(a, b [2]int) bool { return a == b }),
+	}
+	graph.Vertices().Iterate(func(v V) bool {
+		network.indices.Save(v, len(network.vertices))
+		network.vertices = append(network.vertices, v)
+		return true
+	})
+	network.adjacency = make([][]int, len(network.vertices))
+	network.source, network.sink = network.indices.Get(source), network.indices.Get(sink)
+
+	var zero W
+	for _, edge := range directedEdges(graph) {
+		if edge.Weight < zero {
+			panic(_PANIC_MESSAGE_NEGATIVE)
+		}
+		from, to := network.indices.Get(edge.From), network.indices.Get(edge.To)
+		network.edgeArcs.Save([2]int{from, to}, network.addArc(from, to, edge.Weight))
+	}
+	return network
+}
+
+// addArc adds the arc with the capacity and its reverse, with no capacity, returning the number of the first one
+func (network *flowNetwork[V, W]) addArc(from int, to int, capacity W) int {
+	id := len(network.arcs)
+	network.arcs = append(network.arcs, arc[W]{to, capacity, 0, id + 1}, arc[W]{from, 0, 0, id})
+	network.adjacency[from] = append(network.adjacency[from], id)
+	network.adjacency[to] = append(network.adjacency[to], id+1)
+	return id
+}
+
+func (network *flowNetwork[V, W]) residual(id int) W {
+	return network.arcs[id].capacity - network.arcs[id].flow
+}
+
+func (network *flowNetwork[V, W]) hasRoom(id int) bool {
+	var zero W
+	return network.residual(id) > zero
+}
+
+func (network *flowNetwork[V, W]) push(id int, amount W) {
+	network.arcs[id].flow += amount
+	network.arcs[network.arcs[id].reverse].flow -= amount
+}
+
+// augmentingPath searches breadth first for a path from the source to the sink with room on every arc, leaving in
+// parents the arc that reached each vertex. It returns whether the sink was reached.
+func (network *flowNetwork[V, W]) augmentingPath(parents []int) bool {
+	for i := range parents {
+		parents[i] = -1
+	}
+	pending := TDAQueue.NewLinkedQueue[int]()
+	pending.Enqueue(network.source)
+	for !pending.IsEmpty() {
+		v := pending.Dequeue()
+		for _, id := range network.adjacency[v] {
+			w := network.arcs[id].to
+			if w != network.source && parents[w] == -1 && network.hasRoom(id) {
+				parents[w] = id
+				if w == network.sink {
+					return true
+				}
+				pending.Enqueue(w)
+			}
+		}
+	}
+	return false
+}
+
+// level numbers every vertex with its distance from the source in the residual network, or -1 if it is not
+// reached. It returns whether the sink was reached.
+func (network *flowNetwork[V, W]) level(levels []int) bool {
+	for i := range levels {
+		levels[i] = -1
+	}
+	levels[network.source] = 0
+	pending := TDAQueue.NewLinkedQueue[int]()
+	pending.Enqueue(network.source)
+	for !pending.IsEmpty() {
+		v := pending.Dequeue()
+		for _, id := range network.adjacency[v] {
+			if w := network.arcs[id].to; levels[w] == -1 && network.hasRoom(id) {
+				levels[w] = levels[v] + 1
+				pending.Enqueue(w)
+			}
+		}
+	}
+	return levels[network.sink] != -1
+}
+
+// blockingPush sends flow from v to the sink through arcs that go one level further, at most limit of it unless
+// limit is nil, returning the amount sent and whether any was. next[v] is the first arc of v that may still lead
+// to the sink, so arcs that cannot are not tried again in the same phase.
+func (network *flowNetwork[V, W]) blockingPush(v int, levels []int, next []int, limit *W) (W, bool) {
+	var zero W
+	if v == network.sink {
+		return *limit, true
+	}
+
+	for ; next[v] < len(network.adjacency[v]); next[v]++ {
+		id := network.adjacency[v][next[v]]
+		w := network.arcs[id].to
+		if levels[w] != levels[v]+1 || !network.hasRoom(id) {
+			continue
+		}
+
+		room := network.residual(id)
+		if limit != nil {
+			room = min(room, *limit)
+		}
+		if sent, ok := network.blockingPush(w, levels, next, &room); ok {
+			network.push(id, sent)
+			return sent, true
+		}
+	}
+	return zero, false
+}
@@ -0,0 +1,177 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type flowAlgorithm func(TDAGraph.Graph[string, int], string, string) TDAGraph.Flow[string, int]
+
+var FLOW_ALGORITHMS = map[string]flowAlgorithm{
+	"EdmondsKarp": TDAGraph.EdmondsKarp[string, int],
+	"Dinic":       TDAGraph.Dinic[string, int],
+}
+
+// createFlowNetwork creates the network of Introduction to Algorithms, whose maximum flow from s to t is 23
+func createFlowNetwork() TDAGraph.Graph[string, int] {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "s", "v1", "v2", "v3", "v4", "t")
+	graph.AddWeightedEdge("s", "v1", 16)
+	graph.AddWeightedEdge("s", "v2", 13)
+	graph.AddWeightedEdge("v2", "v1", 4)
+	graph.AddWeightedEdge("v1", "v3", 12)
+	graph.AddWeightedEdge("v3", "v2", 9)
+	graph.AddWeightedEdge("v2", "v4", 14)
+	graph.AddWeightedEdge("v4", "v3", 7)
+	graph.AddWeightedEdge("v3", "t", 20)
+	graph.AddWeightedEdge("v4", "t", 4)
+	return graph
+}
+
+// requireValidFlow checks that no edge carries more than its capacity, that the flow is conserved in every vertex
+// but the terminals, and that the minimum cut separates them with a capacity equal to the value of the flow
+func requireValidFlow(t *testing.T, graph TDAGraph.Graph[string, int], flow TDAGraph.Flow[string, int], source, sink string) {
+	balance := map[string]int{}
+	flow.Flows().Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.Greater(t, edge.Weight, 0)
+		require.LessOrEqual(t, edge.Weight, graph.Weight(edge.From, edge.To))
+		require.EqualValues(t, edge.Weight, flow.EdgeFlow(edge.From, edge.To))
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+		return true
+	})
+	graph.Vertices().Iterate(func(v string) bool {
+		switch v {
+		case source:
+			require.EqualValues(t, -flow.Value(), balance[v])
+		case sink:
+			require.EqualValues(t, flow.Value(), balance[v])
+		default:
+			require.EqualValues(t, 0, balance[v], "The flow into %s must equal the flow out of it", v)
+		}
+		return true
+	})
+
+	side, cut := flow.MinCut()
+	sourceSide := map[string]bool{}
+	side.Iterate(func(v string) bool {
+		sourceSide[v] = true
+		return true
+	})
+	require.True(t, sourceSide[source])
+	require.False(t, sourceSide[sink])
+	capacity := 0
+	cut.Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.True(t, sourceSide[edge.From])
+		require.False(t, sourceSide[edge.To])
+		capacity += edge.Weight
+		return true
+	})
+	require.EqualValues(t, flow.Value(), capacity)
+}
+
+func TestFlowInvalidArguments(t *testing.T) {
+	graph := createFlowNetwork()
+	for _, algorithm := range FLOW_ALGORITHMS {
+		require.PanicsWithValue(t, "The source and the sink must be different", func() { algorithm(graph, "s", "s") })
+		require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { algorithm(graph, "s", "z") })
+	}
+
+	flow := TDAGraph.Dinic(graph, "s", "t")
+	require.PanicsWithValue(t, _PANIC_MESSAGE_EDGE, func() { flow.EdgeFlow("t", "s") })
+
+	graph.AddWeightedEdge("v1", "v4", -1)
+	for _, algorithm := range FLOW_ALGORITHMS {
+		require.PanicsWithValue(t, "The graph has negative weights", func() { algorithm(graph, "s", "t") })
+	}
+}
+
+func TestFlowKnownNetwork(t *testing.T) {
+	graph := createFlowNetwork()
+	for name, algorithm := range FLOW_ALGORITHMS {
+		flow := algorithm(graph, "s", "t")
+		require.EqualValues(t, 23, flow.Value(), name)
+		requireValidFlow(t, graph, flow, "s", "t")
+		require.EqualValues(t, 12, flow.EdgeFlow("v1", "v3"), name)
+		require.EqualValues(t, 4, flow.EdgeFlow("v4", "t"), name)
+
+		side, cut := flow.MinCut()
+		require.Equal(t, []string{"s", "v1", "v2", "v4"}, sorted(side), name)
+		require.ElementsMatch(t, []TDAGraph.Edge[string, int]{{"v1", "v3", 12}, {"v4", "v3", 7}, {"v4", "t", 4}}, toSlice(cut), name)
+	}
+}
+
+func TestFlowUnreachableSink(t *testing.T) {
+	graph := createFlowNetwork()
+	graph.AddVertex("island")
+	for name, algorithm := range FLOW_ALGORITHMS {
+		flow := algorithm(graph, "s", "island")
+		require.EqualValues(t, 0, flow.Value(), name)
+		require.True(t, flow.Flows().IsEmpty(), name)
+		_, cut := flow.MinCut()
+		require.True(t, cut.IsEmpty(), name)
+	}
+}
+
+func TestFlowUndirectedNetwork(t *testing.T) {
+	// Flow can go either way through an undirected edge, here from C to B
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Weighted, "A", "B", "C", "D")
+	graph.AddWeightedEdge("A", "B", 1)
+	graph.AddWeightedEdge("A", "C", 5)
+	graph.AddWeightedEdge("B", "C", 3)
+	graph.AddWeightedEdge("B", "D", 4)
+	graph.AddWeightedEdge("C", "D", 2)
+	for name, algorithm := range FLOW_ALGORITHMS {
+		flow := algorithm(graph, "A", "D")
+		require.EqualValues(t, 6, flow.Value(), name)
+		require.EqualValues(t, 3, flow.EdgeFlow("C", "B"), name)
+		require.EqualValues(t, 0, flow.EdgeFlow("B", "C"), name)
+		requireValidFlow(t, graph, flow, "A", "D")
+	}
+}
+
+func TestFlowUnsignedWeights(t *testing.T) {
+	// No flow goes back through an undirected edge, which must not wrap around below zero with unsigned weights
+	graph := TDAGraph.CreateGraph[string, uint](stringEquality, TDAGraph.Undirected, TDAGraph.Weighted)
+	for _, v := range []string{"s", "a", "b", "t"} {
+		graph.AddVertex(v)
+	}
+	graph.AddWeightedEdge("s", "a", 5)
+	graph.AddWeightedEdge("a", "t", 5)
+	graph.AddWeightedEdge("s", "b", 5)
+	graph.AddWeightedEdge("b", "t", 5)
+	for name, algorithm := range map[string]func(TDAGraph.Graph[string, uint], string, string) TDAGraph.Flow[string, uint]{
+		"EdmondsKarp": TDAGraph.EdmondsKarp[string, uint],
+		"Dinic":       TDAGraph.Dinic[string, uint],
+	} {
+		flow := algorithm(graph, "s", "t")
+		require.EqualValues(t, 10, flow.Value(), name)
+		require.EqualValues(t, 5, flow.EdgeFlow("s", "a"), name)
+		require.EqualValues(t, 0, flow.EdgeFlow("a", "s"), name)
+		require.EqualValues(t, 0, flow.EdgeFlow("t", "b"), name)
+		require.ElementsMatch(t, []TDAGraph.Edge[string, uint]{{"s", "a", 5}, {"a", "t", 5}, {"s", "b", 5}, {"b", "t", 5}}, toSlice(flow.Flows()), name)
+	}
+}
+
+func TestFlowRandomNetworks(t *testing.T) {
+	rng := rand.New(rand.NewSource(45))
+	for round := 0; round < 40; round++ {
+		graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted)
+		n := 25
+		for v := 0; v < n; v++ {
+			graph.AddVertex(fmt.Sprint(v))
+		}
+		for i := 0; i < 100; i++ {
+			graph.AddWeightedEdge(fmt.Sprint(rng.Intn(n)), fmt.Sprint(rng.Intn(n)), rng.Intn(30))
+		}
+
+		edmondsKarp := TDAGraph.EdmondsKarp(graph, "0", "1")
+		dinic := TDAGraph.Dinic(graph, "0", "1")
+		require.EqualValues(t, edmondsKarp.Value(), dinic.Value())
+		requireValidFlow(t, graph, edmondsKarp, "0", "1")
+		requireValidFlow(t, graph, dinic, "0", "1")
+	}
+}
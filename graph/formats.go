@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var errTrailingText = errors.New("unexpected text after the number")
+
+// namedGraph is a graph with its vertices replaced by their names, sorted by them so that the same graph is always
+// written the same way. The edges of undirected graphs go from the least name to the other one.
+type namedGraph[W Weight] struct {
+	directed bool
+	weighted bool
+	vertices []string
+	edges    []Edge[string, W]
+}
+
+func nameGraph[V any, W Weight](graph Graph[V, W], name func(V) string) namedGraph[W] {
+	named := namedGraph[W]{directed: graph.IsDirected(), weighted: graph.IsWeighted()}
+	graph.Vertices().Iterate(func(v V) bool {
+		named.vertices = append(named.vertices, name(v))
+		return true
+	})
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		from, to := name(edge.From), name(edge.To)
+		if !named.directed && to < from {
+			from, to = to, from
+		}
+		named.edges = append(named.edges, Edge[string, W]{from, to, edge.Weight})
+		return true
+	})
+
+	slices.Sort(named.vertices)
+	slices.SortFunc(named.edges, func(a, b Edge[string, W]) int {
+		if comparison := strings.Compare(a.From, b.From); comparison != 0 {
+			return comparison
+		}
+		return strings.Compare(a.To, b.To)
+	})
+	return named
+}
+
+// isolated returns the names of the vertices without edges, which only edge lists need to write apart
+func (named namedGraph[W]) isolated() []string {
+	joined := map[string]bool{}
+	for _, edge := range named.edges {
+		joined[edge.From], joined[edge.To] = true, true
+	}
+	var vertices []string
+	for _, v := range named.vertices {
+		if !joined[v] {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// graphBuilder creates a graph from the names read from a text, parsing each name the first time it appears
+type graphBuilder[V any, W Weight] struct {
+	graph    Graph[V, W]
+	parse    func(string) (V, error)
+	vertices map[string]V
+}
+
+func newGraphBuilder[V any, W Weight](cmp func(V, V) bool, parse func(string) (V, error), directed bool, weighted bool) *graphBuilder[V, W] {
+	direction, weighting := Undirected, Unweighted
+	if directed {
+		direction = Directed
+	}
+	if weighted {
+		weighting = Weighted
+	}
+	return &graphBuilder[V, W]{CreateGraph[V, W](cmp, direction, weighting), parse, map[string]V{}}
+}
+
+func (builder *graphBuilder[V, W]) addVertex(name string) (V, error) {
+	if v, ok := builder.vertices[name]; ok {
+		return v, nil
+	}
+	v, err := builder.parse(name)
+	if err != nil {
+		return v, fmt.Errorf("vertex %q: %w", name, err)
+	}
+	builder.vertices[name] = v
+	builder.graph.AddVertex(v)
+	return v, nil
+}
+
+func (builder *graphBuilder[V, W]) addEdge(from string, to string, weight W) error {
+	u, err := builder.addVertex(from)
+	if err != nil {
+		return err
+	}
+	v, err := builder.addVertex(to)
+	if err != nil {
+		return err
+	}
+	if builder.graph.IsWeighted() {
+		builder.graph.AddWeightedEdge(u, v, weight)
+	} else {
+		builder.graph.AddEdge(u, v)
+	}
+	return nil
+}
+
+func formatWeight[W Weight](weight W) string {
+	return fmt.Sprint(weight)
+}
+
+func parseWeight[W Weight](text string) (W, error) {
+	var weight W
+	reader := strings.NewReader(text)
+	if _, err := fmt.Fscan(reader, &weight); err != nil {
+		return weight, fmt.Errorf("weight %q: %w", text, err)
+	}
+	if reader.Len() > 0 {
+		return weight, fmt.Errorf("weight %q: %w", text, errTrailingText)
+	}
+	return weight, nil
+}
+
+// String formats the graph with its vertices and edges sorted by their text, like
+// digraph{vertices: [A B C], edges: [A->B:3 B->C:1]}. Undirected graphs are written as graph{...} with A-B edges,
+// and the edges of unweighted graphs have no weight.
+func (graph *adjacencyGraph[V, W]) String() string {
+	named := nameGraph[V, W](graph, func(v V) string { return fmt.Sprint(v) })
+	kind, arrow := "graph", "-"
+	if named.directed {
+		kind, arrow = "digraph", "->"
+	}
+	edges := make([]string, len(named.edges))
+	for i, edge := range named.edges {
+		edges[i] = edge.From + arrow + edge.To
+		if named.weighted {
+			edges[i] += ":" + formatWeight(edge.Weight)
+		}
+	}
+	return fmt.Sprintf("%s{vertices: [%s], edges: [%s]}", kind, strings.Join(named.vertices, " "), strings.Join(edges, " "))
+}
+
+// GoString formats the graph as Go syntax, like
+// graph.Graph[string, int]{Direction: Directed, Weighting: Weighted, Vertices: {"A", "B"}, Edges: {{"A", "B", 3}}},
+// where every edge is an Edge.
+func (graph *adjacencyGraph[V, W]) GoString() string {
+	named := nameGraph[V, W](graph, func(v V) string { return fmt.Sprintf("%#v", v) })
+	direction, weighting := "Undirected", "Unweighted"
+	if named.directed {
+		direction = "Directed"
+	}
+	if named.weighted {
+		weighting = "Weighted"
+	}
+	edges := make([]string, len(named.edges))
+	for i, edge := range named.edges {
+		edges[i] = fmt.Sprintf("{%s, %s, %#v}", edge.From, edge.To, edge.Weight)
+	}
+	return fmt.Sprintf("graph.Graph[%v, %v]{Direction: %s, Weighting: %s, Vertices: {%s}, Edges: {%s}}",
+		reflect.TypeFor[V](), reflect.TypeFor[W](), direction, weighting, strings.Join(named.vertices, ", "), strings.Join(edges, ", "))
+}
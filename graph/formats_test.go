@@ -0,0 +1,279 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type format struct {
+	write func(io.Writer, TDAGraph.Graph[string, int], func(string) string) error
+	read  func(io.Reader, func(string, string) bool, func(string) (string, error)) (TDAGraph.Graph[string, int], error)
+}
+
+var FORMATS = map[string]format{
+	"DOT":      {TDAGraph.WriteDOT[string, int], TDAGraph.ReadDOT[string, int]},
+	"EdgeList": {TDAGraph.WriteEdgeList[string, int], TDAGraph.ReadEdgeList[string, int]},
+	"JSON":     {TDAGraph.WriteJSON[string, int], TDAGraph.ReadJSON[string, int]},
+}
+
+func identity(name string) string {
+	return name
+}
+
+func parseIdentity(name string) (string, error) {
+	return name, nil
+}
+
+func write(t *testing.T, format format, graph TDAGraph.Graph[string, int]) string {
+	var buffer bytes.Buffer
+	require.NoError(t, format.write(&buffer, graph, identity))
+	return buffer.String()
+}
+
+func read(format format, text string) (TDAGraph.Graph[string, int], error) {
+	return format.read(strings.NewReader(text), stringEquality, parseIdentity)
+}
+
+// requireSameGraph checks that both graphs are of the same kind, with the same vertices and edges
+func requireSameGraph(t *testing.T, expected TDAGraph.Graph[string, int], actual TDAGraph.Graph[string, int]) {
+	require.Equal(t, expected.IsDirected(), actual.IsDirected())
+	require.Equal(t, expected.IsWeighted(), actual.IsWeighted())
+	require.Equal(t, sorted(expected.Vertices()), sorted(actual.Vertices()))
+	require.EqualValues(t, expected.EdgeCount(), actual.EdgeCount())
+	expected.Edges().Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.True(t, actual.HasEdge(edge.From, edge.To), "%q -> %q is missing", edge.From, edge.To)
+		require.EqualValues(t, edge.Weight, actual.Weight(edge.From, edge.To))
+		return true
+	})
+}
+
+// createSampleGraph creates a graph of the kind with a self loop, a vertex without edges and names that need quoting
+func createSampleGraph(direction TDAGraph.Direction, weighting TDAGraph.Weighting) TDAGraph.Graph[string, int] {
+	graph := createGraph(direction, weighting, "A", "B", "new york", `say "hi"`, `back\slash`, "ñandú", "", "#tag", "isolated")
+	edges := []TDAGraph.Edge[string, int]{
+		{"A", "B", 3}, {"B", "new york", -2}, {"new york", `say "hi"`, 0}, {`back\slash`, "A", 10},
+		{"ñandú", "", 7}, {"#tag", "#tag", 1}, {"B", "A", 4},
+	}
+	for _, edge := range edges {
+		if weighting == TDAGraph.Weighted {
+			graph.AddWeightedEdge(edge.From, edge.To, edge.Weight)
+		} else {
+			graph.AddEdge(edge.From, edge.To)
+		}
+	}
+	return graph
+}
+
+func TestFormatsRoundTrip(t *testing.T) {
+	for name, format := range FORMATS {
+		for _, direction := range []TDAGraph.Direction{TDAGraph.Directed, TDAGraph.Undirected} {
+			for _, weighting := range []TDAGraph.Weighting{TDAGraph.Weighted, TDAGraph.Unweighted} {
+				graph := createSampleGraph(direction, weighting)
+				text := write(t, format, graph)
+				copied, err := read(format, text)
+				require.NoError(t, err, "%s:\n%s", name, text)
+				requireSameGraph(t, graph, copied)
+				require.Equal(t, text, write(t, format, copied), "%s: writing the copy gives the same text", name)
+			}
+
+			empty := createGraph(direction, TDAGraph.Weighted)
+			copied, err := read(format, write(t, format, empty))
+			require.NoError(t, err, name)
+			requireSameGraph(t, empty, copied)
+		}
+	}
+}
+
+func TestFormatsDeterministicOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(46))
+	var vertices []string
+	for i := 0; i < 30; i++ {
+		vertices = append(vertices, fmt.Sprintf("v%d", i))
+	}
+	var edges [][2]string
+	for i := 0; i < 60; i++ {
+		edges = append(edges, [2]string{vertices[rng.Intn(30)], vertices[rng.Intn(30)]})
+	}
+
+	for name, format := range FORMATS {
+		var texts []string
+		for round := 0; round < 5; round++ {
+			// The same graph, built in a different order every time
+			graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted)
+			for _, i := range rng.Perm(len(vertices)) {
+				graph.AddVertex(vertices[i])
+			}
+			for _, i := range rng.Perm(len(edges)) {
+				from, to := edges[i][0], edges[i][1]
+				if rng.Intn(2) == 0 {
+					from, to = to, from
+				}
+				graph.AddEdge(from, to)
+			}
+			texts = append(texts, write(t, format, graph))
+		}
+		for _, text := range texts[1:] {
+			require.Equal(t, texts[0], text, name)
+		}
+	}
+}
+
+func TestFormatsExpectedText(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "b", "a", "c", "lone wolf")
+	graph.AddWeightedEdge("b", "c", 2)
+	graph.AddWeightedEdge("a", "b", 1)
+
+	require.Equal(t, `digraph {
+	graph [weighted=true];
+	"a";
+	"b";
+	"c";
+	"lone wolf";
+	"a" -> "b" [label="1"];
+	"b" -> "c" [label="2"];
+}
+`, write(t, FORMATS["DOT"], graph))
+
+	require.Equal(t, `directed weighted
+a b 1
+b c 2
+"lone wolf"
+`, write(t, FORMATS["EdgeList"], graph))
+
+	require.Equal(t, `{
+	"directed": true,
+	"weighted": true,
+	"adjacency": {
+		"a": [
+			{
+				"to": "b",
+				"weight": 1
+			}
+		],
+		"b": [
+			{
+				"to": "c",
+				"weight": 2
+			}
+		],
+		"c": [],
+		"lone wolf": []
+	}
+}
+`, write(t, FORMATS["JSON"], graph))
+}
+
+func TestReadHandwrittenDOT(t *testing.T) {
+	graph, err := read(FORMATS["DOT"], `
+# A preprocessor line
+strict digraph pipeline {
+	// Attribute statements are skipped, except for the weight mark
+	node [shape=box, color="red"]; rankdir=LR
+	weighted = true
+	/* Chains of edges share their attributes,
+	   and names may be words or numbers */
+	fetch -> parse -> "store results" [label=5 style=bold]
+	parse -> -1.5 [label="2"]; lonely
+}`)
+	require.NoError(t, err)
+	require.True(t, graph.IsDirected())
+	require.True(t, graph.IsWeighted())
+	require.Equal(t, []string{"-1.5", "fetch", "lonely", "parse", "store results"}, sorted(graph.Vertices()))
+	require.EqualValues(t, 5, graph.Weight("fetch", "parse"))
+	require.EqualValues(t, 5, graph.Weight("parse", "store results"))
+	require.EqualValues(t, 2, graph.Weight("parse", "-1.5"))
+
+	graph, err = read(FORMATS["DOT"], `graph { a -- b -- c; c [label="ignored"] }`)
+	require.NoError(t, err)
+	require.False(t, graph.IsDirected())
+	require.False(t, graph.IsWeighted())
+	require.EqualValues(t, 2, graph.EdgeCount())
+	require.True(t, graph.HasEdge("a", "b"))
+	require.True(t, graph.HasEdge("c", "b"))
+}
+
+func TestReadFloatWeights(t *testing.T) {
+	graph := TDAGraph.CreateGraph[string, float64](stringEquality, TDAGraph.Directed, TDAGraph.Weighted)
+	graph.AddVertex("x")
+	graph.AddVertex("y")
+	graph.AddWeightedEdge("x", "y", 0.1+0.2)
+	graph.AddWeightedEdge("y", "x", -1e-9)
+
+	var buffer bytes.Buffer
+	require.NoError(t, TDAGraph.WriteEdgeList(&buffer, graph, identity))
+	copied, err := TDAGraph.ReadEdgeList[string, float64](&buffer, stringEquality, parseIdentity)
+	require.NoError(t, err)
+	require.Equal(t, 0.1+0.2, copied.Weight("x", "y"), "Weights are written with all their precision")
+	require.Equal(t, -1e-9, copied.Weight("y", "x"))
+}
+
+func TestReadParsesVertices(t *testing.T) {
+	graph := TDAGraph.CreateGraph[int, int](intEquality, TDAGraph.Undirected, TDAGraph.Unweighted)
+	for v := 1; v <= 3; v++ {
+		graph.AddVertex(v)
+	}
+	graph.AddEdge(1, 2)
+
+	var buffer bytes.Buffer
+	require.NoError(t, TDAGraph.WriteJSON(&buffer, graph, strconv.Itoa))
+	copied, err := TDAGraph.ReadJSON[int, int](&buffer, intEquality, strconv.Atoi)
+	require.NoError(t, err)
+	require.True(t, copied.HasEdge(2, 1))
+	require.True(t, copied.HasVertex(3))
+
+	_, err = TDAGraph.ReadEdgeList[int, int](strings.NewReader("undirected unweighted\n1 two\n"), intEquality, strconv.Atoi)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	require.ErrorContains(t, err, "line 2")
+}
+
+func TestReadInvalidInput(t *testing.T) {
+	invalid := map[string][]string{
+		"DOT": {
+			"",
+			"tree { a }",
+			"digraph { a -- b }",
+			"graph { a -> b }",
+			"digraph { a -> b",
+			`digraph { "a }`,
+			"digraph { /* a }",
+			"digraph { subgraph s { a } }",
+			"digraph { a -> { b c } }",
+			"digraph { a:n -> b }",
+			"digraph { graph [weighted=true] a -> b }",
+			`digraph { weighted=true; a -> b [label="heavy"] }`,
+			"digraph { a } b",
+			"digraph { a -> b @ }",
+		},
+		"EdgeList": {
+			"",
+			"# Only a comment",
+			"sideways weighted\n",
+			"directed\n",
+			"directed weighted\na b\n",
+			"directed weighted\na b 1.5\n",
+			"directed unweighted\na b c\n",
+			`undirected unweighted` + "\n" + `"a b`,
+		},
+		"JSON": {
+			"",
+			"[]",
+			`{"directed": true, "weighted": true, "adjacency": {"a": [{"to": "b"}]}}`,
+			`{"directed": true, "weighted": true, "adjacency": {"a": [{"to": "b", "weight": "one"}]}}`,
+			`{"directed": true`,
+		},
+	}
+	for name, texts := range invalid {
+		for _, text := range texts {
+			_, err := read(FORMATS[name], text)
+			require.Error(t, err, "%s: %q", name, text)
+		}
+	}
+}
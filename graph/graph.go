@@ -0,0 +1,94 @@
+package graph
+
+import (
+	TDAList "adts/list"
+)
+
+// Weight is the type of the weights of the edges, which the algorithms over graphs add and compare.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Edge is an edge of a graph, going from From to To. Edges of undirected graphs go both ways, and are listed once.
+type Edge[V any, W Weight] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Direction tells whether the edges of a graph go one way or both ways.
+type Direction int
+
+const (
+	Directed Direction = iota
+	Undirected
+)
+
+// Weighting tells whether the edges of a graph carry their own weights or all weigh 1.
+type Weighting int
+
+const (
+	Weighted Weighting = iota
+	Unweighted
+)
+
+// Graph is a set of vertices joined by edges, with at most one edge from one vertex to another.
+type Graph[V any, W Weight] interface {
+
+	// IsDirected determines whether the edges go one way or both ways.
+	IsDirected() bool
+
+	// IsWeighted determines whether the edges carry their own weights or all weigh 1.
+	IsWeighted() bool
+
+	// AddVertex adds the vertex to the graph, without edges. It does nothing if the vertex already belongs to it.
+	AddVertex(v V)
+
+	// RemoveVertex removes the vertex and every edge that touches it. If the vertex does not belong to the graph,
+	// it must panic with the message 'The vertex does not belong to the graph'.
+	RemoveVertex(v V)
+
+	// HasVertex determines whether the vertex belongs to the graph or not.
+	HasVertex(v V) bool
+
+	// SameVertex determines whether both values stand for the same vertex, as the graph compares them.
+	SameVertex(a V, b V) bool
+
+	// AddEdge adds an edge from one vertex to the other that weighs 1, replacing the edge between them if there
+	// was one. If any of the vertices does not belong to the graph, it must panic with the message
+	// 'The vertex does not belong to the graph'.
+	AddEdge(from V, to V)
+
+	// AddWeightedEdge works as AddEdge, but the edge has the given weight. If the graph is unweighted, it must
+	// panic with the message 'The graph is not weighted'.
+	AddWeightedEdge(from V, to V, weight W)
+
+	// RemoveEdge removes the edge from one vertex to the other. If there is no such edge, it must panic with the
+	// message 'The edge does not belong to the graph'.
+	RemoveEdge(from V, to V)
+
+	// HasEdge determines whether there is an edge from one vertex to the other or not.
+	HasEdge(from V, to V) bool
+
+	// Weight returns the weight of the edge from one vertex to the other. If there is no such edge, it must panic
+	// with the message 'The edge does not belong to the graph'.
+	Weight(from V, to V) W
+
+	// Adjacent returns a new list with the vertices reached by an edge from v. If the vertex does not belong to
+	// the graph, it must panic with the message 'The vertex does not belong to the graph'.
+	Adjacent(v V) TDAList.List[V]
+
+	// Vertices returns a new list with the vertices of the graph.
+	Vertices() TDAList.List[V]
+
+	// Edges returns a new list with the edges of the graph.
+	Edges() TDAList.List[Edge[V, W]]
+
+	// VertexCount returns the number of vertices of the graph.
+	VertexCount() int
+
+	// EdgeCount returns the number of edges of the graph, counting once those of undirected graphs.
+	EdgeCount() int
+}
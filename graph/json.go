@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// jsonGraph is the JSON adjacency format: the kind of graph and, for the name of every vertex, the vertices its
+// edges go to. The edges of undirected graphs are listed from both of their vertices.
+type jsonGraph[W Weight] struct {
+	Directed  bool                          `json:"directed"`
+	Weighted  bool                          `json:"weighted"`
+	Adjacency map[string][]jsonNeighbour[W] `json:"adjacency"`
+}
+
+// jsonNeighbour is the end of an edge, with its weight only if the graph is weighted
+type jsonNeighbour[W Weight] struct {
+	To     string `json:"to"`
+	Weight *W     `json:"weight,omitempty"`
+}
+
+// WriteJSON writes the graph as a JSON object, naming every vertex with name, as in
+//
+//	{"directed": true, "weighted": true, "adjacency": {"A": [{"to": "B", "weight": 3}], "B": []}}
+//
+// where every vertex lists the vertices its edges go to. The vertices and their neighbours are written sorted by
+// their names, so that the same graph is always written the same way.
+func WriteJSON[V any, W Weight](w io.Writer, graph Graph[V, W], name func(V) string) error {
+	named := nameGraph(graph, name)
+	document := jsonGraph[W]{named.directed, named.weighted, map[string][]jsonNeighbour[W]{}}
+	for _, v := range named.vertices {
+		document.Adjacency[v] = []jsonNeighbour[W]{}
+	}
+
+	addNeighbour := func(from string, to string, weight W) {
+		neighbour := jsonNeighbour[W]{To: to}
+		if named.weighted {
+			neighbour.Weight = &weight
+		}
+		document.Adjacency[from] = append(document.Adjacency[from], neighbour)
+	}
+	for _, edge := range named.edges {
+		addNeighbour(edge.From, edge.To, edge.Weight)
+		if !named.directed && edge.From != edge.To {
+			addNeighbour(edge.To, edge.From, edge.Weight)
+		}
+	}
+	for _, neighbours := range document.Adjacency {
+		slices.SortFunc(neighbours, func(a, b jsonNeighbour[W]) int {
+			return strings.Compare(a.To, b.To)
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(document)
+}
+
+// ReadJSON reads a graph written by WriteJSON, parsing the name of every vertex with parse and telling vertices
+// apart with cmp. The neighbours of every vertex need not be listed as vertices themselves.
+func ReadJSON[V any, W Weight](r io.Reader, cmp func(V, V) bool, parse func(string) (V, error)) (Graph[V, W], error) {
+	var document jsonGraph[W]
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+
+	builder := newGraphBuilder[V, W](cmp, parse, document.Directed, document.Weighted)
+	vertices := make([]string, 0, len(document.Adjacency))
+	for v := range document.Adjacency {
+		vertices = append(vertices, v)
+	}
+	slices.Sort(vertices)
+
+	for _, v := range vertices {
+		if _, err := builder.addVertex(v); err != nil {
+			return nil, fmt.Errorf("json: %w", err)
+		}
+	}
+	for _, v := range vertices {
+		for _, neighbour := range document.Adjacency[v] {
+			var weight W
+			if document.Weighted {
+				if neighbour.Weight == nil {
+					return nil, fmt.Errorf("json: the edge %q %q has no weight", v, neighbour.To)
+				}
+				weight = *neighbour.Weight
+			}
+			if err := builder.addEdge(v, neighbour.To, weight); err != nil {
+				return nil, fmt.Errorf("json: %w", err)
+			}
+		}
+	}
+	return builder.graph, nil
+}
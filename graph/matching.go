@@ -0,0 +1,145 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAQueue "adts/queue"
+)
+
+const _PANIC_MESSAGE_BIPARTITE = "The graph is not bipartite"
+
+// bipartiteMatching numbers the vertices of each side apart, and keeps for each of them the number of its match on
+// the other side, or -1 if it is unmatched. distances are the layers of the alternating paths found in a phase.
+type bipartiteMatching[V any] struct {
+	leftVertices  []V
+	rightVertices []V
+	neighbours    [][]int
+	leftMatch     []int
+	rightMatch    []int
+	distances     []int
+}
+
+// HopcroftKarp finds a maximum matching of a bipartite graph, the most edges that share no vertex, where left holds
+// the vertices of one side and every other vertex is on the other one. It works in phases, each of which finds
+// the shortest paths that alternate between unmatched and matched edges and then flips as many of them as it can.
+// It returns the matched edges, from the left side to the other one. It panics with the message 'The graph is not
+// bipartite' if an edge joins two vertices on the same side, and with 'The vertex does not belong to the graph'
+// if a vertex of left does not belong to the graph.
+func HopcroftKarp[V any, W Weight](graph Graph[V, W], left TDAList.List[V]) TDAList.List[Edge[V, W]] {
+	matching := newBipartiteMatching(graph, left)
+	for matching.layer() {
+		for u := range matching.leftVertices {
+			if matching.leftMatch[u] == -1 {
+				matching.augment(u)
+			}
+		}
+	}
+
+	matched := TDAList.CreateLinkedList[Edge[V, W]]()
+	for u, v := range matching.leftMatch {
+		if v != -1 {
+			from, to := matching.leftVertices[u], matching.rightVertices[v]
+			matched.InsertLast(Edge[V, W]{from, to, graph.Weight(from, to)})
+		}
+	}
+	return matched
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func newBipartiteMatching[V any, W Weight](graph Graph[V, W], left TDAList.List[V]) *bipartiteMatching[V] {
+	matching := &bipartiteMatching[V]{}
+	leftIndices := TDADictionary.CreateHash[V, int](graph.SameVertex)
+	left.Iterate(func(u V) bool {
+		if !graph.HasVertex(u) {
+			panic(_PANIC_MESSAGE_VERTEX)
+		}
+		if !leftIndices.Belongs(u) {
+			leftIndices.Save(u, len(matching.leftVertices))
+			matching.leftVertices = append(matching.leftVertices, u)
+		}
+		return true
+	})
+
+	rightIndices := TDADictionary.CreateHash[V, int](graph.SameVertex)
+	graph.Vertices().Iterate(func(v V) bool {
+		if !leftIndices.Belongs(v) {
+			rightIndices.Save(v, len(matching.rightVertices))
+			matching.rightVertices = append(matching.rightVertices, v)
+		}
+		return true
+	})
+
+	matching.neighbours = make([][]int, len(matching.leftVertices))
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		from, to := edge.From, edge.To
+		if leftIndices.Belongs(from) == leftIndices.Belongs(to) {
+			panic(_PANIC_MESSAGE_BIPARTITE)
+		}
+		if !leftIndices.Belongs(from) {
+			if graph.IsDirected() {
+				// A directed edge only joins its vertices going from the left side
+				return true
+			}
+			from, to = to, from
+		}
+		u := leftIndices.Get(from)
+		matching.neighbours[u] = append(matching.neighbours[u], rightIndices.Get(to))
+		return true
+	})
+
+	matching.leftMatch = make([]int, len(matching.leftVertices))
+	matching.rightMatch = make([]int, len(matching.rightVertices))
+	matching.distances = make([]int, len(matching.leftVertices))
+	for u := range matching.leftMatch {
+		matching.leftMatch[u] = -1
+	}
+	for v := range matching.rightMatch {
+		matching.rightMatch[v] = -1
+	}
+	return matching
+}
+
+// layer searches breadth first from the unmatched left vertices, going to the other side through any edge and
+// back through matched ones, numbering every left vertex with its layer or -1 if it is not reached. It returns
+// whether an unmatched vertex of the other side was reached, which is the end of an augmenting path.
+func (matching *bipartiteMatching[V]) layer() bool {
+	pending := TDAQueue.NewLinkedQueue[int]()
+	for u := range matching.leftVertices {
+		matching.distances[u] = -1
+		if matching.leftMatch[u] == -1 {
+			matching.distances[u] = 0
+			pending.Enqueue(u)
+		}
+	}
+
+	found := false
+	for !pending.IsEmpty() {
+		u := pending.Dequeue()
+		for _, v := range matching.neighbours[u] {
+			next := matching.rightMatch[v]
+			if next == -1 {
+				found = true
+			} else if matching.distances[next] == -1 {
+				matching.distances[next] = matching.distances[u] + 1
+				pending.Enqueue(next)
+			}
+		}
+	}
+	return found
+}
+
+// augment searches depth first, through the layers, for an alternating path from u to an unmatched vertex of the
+// other side, flipping it if it is found. A vertex it fails from leaves the layers for the rest of the phase.
+func (matching *bipartiteMatching[V]) augment(u int) bool {
+	for _, v := range matching.neighbours[u] {
+		next := matching.rightMatch[v]
+		if next == -1 || (matching.distances[next] == matching.distances[u]+1 && matching.augment(next)) {
+			matching.leftMatch[u] = v
+			matching.rightMatch[v] = u
+			return true
+		}
+	}
+	matching.distances[u] = -1
+	return false
+}
@@ -0,0 +1,103 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func listOf[T any](elements ...T) TDAList.List[T] {
+	list := TDAList.CreateLinkedList[T]()
+	for _, element := range elements {
+		list.InsertLast(element)
+	}
+	return list
+}
+
+// requireMatching checks that the edges belong to the graph, go from the left side and share no vertex
+func requireMatching(t *testing.T, graph TDAGraph.Graph[string, int], left []string, matching TDAList.List[TDAGraph.Edge[string, int]]) {
+	used := map[string]bool{}
+	matching.Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.Contains(t, left, edge.From)
+		require.True(t, graph.HasEdge(edge.From, edge.To))
+		require.False(t, used[edge.From], "%s is matched twice", edge.From)
+		require.False(t, used[edge.To], "%s is matched twice", edge.To)
+		used[edge.From], used[edge.To] = true, true
+		return true
+	})
+}
+
+func TestHopcroftKarpInvalidArguments(t *testing.T) {
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted, "A", "B", "X")
+	graph.AddEdge("A", "X")
+	require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { TDAGraph.HopcroftKarp(graph, listOf("A", "Z")) })
+
+	graph.AddEdge("A", "B")
+	require.PanicsWithValue(t, "The graph is not bipartite", func() { TDAGraph.HopcroftKarp(graph, listOf("A", "B")) })
+}
+
+func TestHopcroftKarpAssignment(t *testing.T) {
+	// Workers and the jobs they can do, where a greedy choice of Ana for backend leaves someone without a job
+	workers := []string{"Ana", "Bruno", "Carla", "Diego"}
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Weighted, append(workers, "backend", "frontend", "infra", "design", "docs")...)
+	graph.AddWeightedEdge("Ana", "backend", 3)
+	graph.AddWeightedEdge("Ana", "frontend", 1)
+	graph.AddWeightedEdge("backend", "Bruno", 2)
+	graph.AddWeightedEdge("Bruno", "infra", 5)
+	graph.AddWeightedEdge("Carla", "backend", 4)
+	graph.AddWeightedEdge("Diego", "infra", 1)
+	graph.AddWeightedEdge("Diego", "design", 2)
+
+	matching := TDAGraph.HopcroftKarp(graph, listOf(workers...))
+	require.EqualValues(t, 4, matching.Length())
+	requireMatching(t, graph, workers, matching)
+	require.ElementsMatch(t, []TDAGraph.Edge[string, int]{
+		{"Ana", "frontend", 1}, {"Bruno", "infra", 5}, {"Carla", "backend", 4}, {"Diego", "design", 2},
+	}, toSlice(matching), "The only perfect matching of the workers")
+}
+
+func TestHopcroftKarpDirectedAndEmpty(t *testing.T) {
+	// Directed edges only count from the left side
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Unweighted, "A", "B", "X", "Y")
+	graph.AddEdge("A", "X")
+	graph.AddEdge("Y", "B")
+	matching := TDAGraph.HopcroftKarp(graph, listOf("A", "B"))
+	require.Equal(t, []TDAGraph.Edge[string, int]{{"A", "X", 1}}, toSlice(matching))
+
+	empty := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted, "A", "X")
+	require.True(t, TDAGraph.HopcroftKarp(empty, listOf("A")).IsEmpty(), "Without edges nothing is matched")
+}
+
+func TestHopcroftKarpRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(46))
+	for round := 0; round < 40; round++ {
+		// The size of a maximum matching is the maximum flow from a source joined to the left side to a sink joined
+		// to the right one, through edges of capacity 1
+		graph := createGraph(TDAGraph.Undirected, TDAGraph.Unweighted)
+		network := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "source", "sink")
+		var left []string
+		for i := 0; i < 30; i++ {
+			l, r := fmt.Sprintf("L%d", i), fmt.Sprintf("R%d", i)
+			left = append(left, l)
+			for _, g := range []TDAGraph.Graph[string, int]{graph, network} {
+				g.AddVertex(l)
+				g.AddVertex(r)
+			}
+			network.AddWeightedEdge("source", l, 1)
+			network.AddWeightedEdge(r, "sink", 1)
+		}
+		for i := 0; i < 50; i++ {
+			l, r := fmt.Sprintf("L%d", rng.Intn(30)), fmt.Sprintf("R%d", rng.Intn(30))
+			graph.AddEdge(r, l)
+			network.AddWeightedEdge(l, r, 1)
+		}
+
+		matching := TDAGraph.HopcroftKarp(graph, listOf(left...))
+		requireMatching(t, graph, left, matching)
+		require.EqualValues(t, TDAGraph.Dinic(network, "source", "sink").Value(), matching.Length())
+	}
+}
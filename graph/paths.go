@@ -0,0 +1,89 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+)
+
+const _PANIC_MESSAGE_UNREACHED = "The vertex was not reached"
+
+// Paths is the tree of paths found by a search from a source vertex, with one path to every vertex it reached.
+type Paths[V any, W Weight] interface {
+
+	// Source returns the vertex the search started from.
+	Source() V
+
+	// Reached determines whether the search found a path to the vertex or not.
+	Reached(v V) bool
+
+	// Distance returns the length of the path to the vertex, which is the sum of the weights of its edges for
+	// shortest path searches and the number of its edges for traversals. If the vertex was not reached, it must
+	// panic with the message 'The vertex was not reached'.
+	Distance(v V) W
+
+	// PathTo returns a new list with the vertices of the path from the source to the vertex, both included. If the
+	// vertex was not reached, it must panic with the message 'The vertex was not reached'.
+	PathTo(v V) TDAList.List[V]
+}
+
+// searchPaths stores, for every reached vertex, its distance and the vertex before it in its path. The source
+// is the only reached vertex without a parent.
+type searchPaths[V any, W Weight] struct {
+	source    V
+	distances TDADictionary.Dictionary[V, W]
+	parents   TDADictionary.Dictionary[V, V]
+}
+
+func newSearchPaths[V any, W Weight, G Weight](graph Graph[V, G], source V) *searchPaths[V, W] {
+	if !graph.HasVertex(source) {
+		panic(_PANIC_MESSAGE_VERTEX)
+	}
+
+	paths := &searchPaths[V, W]{
+		source:    source,
+		distances: TDADictionary.CreateHash[V, W](graph.SameVertex),
+		parents:   TDADictionary.CreateHash[V, V](graph.SameVertex),
+	}
+	var zero W
+	paths.distances.Save(source, zero)
+	return paths
+}
+
+// ----------------------- PATHS PRIMITIVES -----------------------
+
+func (paths *searchPaths[V, W]) Source() V {
+	return paths.source
+}
+
+func (paths *searchPaths[V, W]) Reached(v V) bool {
+	return paths.distances.Belongs(v)
+}
+
+func (paths *searchPaths[V, W]) Distance(v V) W {
+	if !paths.Reached(v) {
+		panic(_PANIC_MESSAGE_UNREACHED)
+	}
+	return paths.distances.Get(v)
+}
+
+func (paths *searchPaths[V, W]) PathTo(v V) TDAList.List[V] {
+	if !paths.Reached(v) {
+		panic(_PANIC_MESSAGE_UNREACHED)
+	}
+
+	path := TDAList.CreateLinkedList[V]()
+	path.InsertFirst(v)
+	for paths.parents.Belongs(v) {
+		v = paths.parents.Get(v)
+		path.InsertFirst(v)
+	}
+	return path
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// reach records that the vertex is reached from parent, at the given distance
+func (paths *searchPaths[V, W]) reach(v V, parent V, distance W) {
+	paths.distances.Save(v, distance)
+	paths.parents.Save(v, parent)
+}
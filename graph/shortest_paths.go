@@ -0,0 +1,191 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAPriorityQueue "adts/priorityqueue"
+)
+
+const _PANIC_MESSAGE_NEGATIVE = "The graph has negative weights"
+
+// candidate is a vertex waiting in a priority queue, with the priority it had when it was queued
+type candidate[V any, W Weight] struct {
+	vertex   V
+	priority W
+}
+
+// Dijkstra finds the shortest paths from the source to every vertex it can reach. It panics with the message
+// 'The graph has negative weights' if it finds a negative edge, and with 'The vertex does not belong to the graph'
+// if the source does not belong to the graph.
+func Dijkstra[V any, W Weight](graph Graph[V, W], source V) Paths[V, W] {
+	paths := newSearchPaths[V, W](graph, source)
+	var zero W
+	search := newPrioritySearch(graph, source, zero)
+
+	for !search.isEmpty() {
+		v := search.next()
+		for iter := graph.Adjacent(v).Iterator(); iter.HasNext(); iter.Next() {
+			w := iter.Current()
+			weight := graph.Weight(v, w)
+			if weight < 0 {
+				panic(_PANIC_MESSAGE_NEGATIVE)
+			}
+
+			distance := paths.Distance(v) + weight
+			if !search.isSettled(w) && (!paths.Reached(w) || distance < paths.Distance(w)) {
+				paths.reach(w, v, distance)
+				search.offer(w, distance)
+			}
+		}
+	}
+	return paths
+}
+
+// BellmanFord finds the shortest paths from the source to every vertex it can reach, allowing negative weights.
+// If a cycle of negative length can be reached from the source, shortest paths do not exist: then paths is nil
+// and cycle holds the vertices of one such cycle, in order and starting from any of them. Otherwise cycle is
+// empty. If the source does not belong to the graph, it panics with 'The vertex does not belong to the graph'.
+func BellmanFord[V any, W Weight](graph Graph[V, W], source V) (paths Paths[V, W], cycle TDAList.List[V]) {
+	found := newSearchPaths[V, W](graph, source)
+	edges := directedEdges(graph)
+
+	// After as many rounds as vertices, a vertex that can still be improved is reached through a negative cycle
+	for round := 0; round < graph.VertexCount(); round++ {
+		var improved *Edge[V, W]
+		for _, edge := range edges {
+			if found.Reached(edge.From) {
+				distance := found.Distance(edge.From) + edge.Weight
+				if !found.Reached(edge.To) || distance < found.Distance(edge.To) {
+					found.reach(edge.To, edge.From, distance)
+					improved = &edge
+				}
+			}
+		}
+		if improved == nil {
+			return found, TDAList.CreateLinkedList[V]()
+		}
+		if round == graph.VertexCount()-1 {
+			return nil, negativeCycle(graph, found, improved.To)
+		}
+	}
+	return found, TDAList.CreateLinkedList[V]()
+}
+
+// AStar finds a shortest path from the source to the target, returning its vertices, its length and true, or
+// false if the target cannot be reached. heuristic must estimate the length of the shortest path from a vertex to
+// the target without overestimating it, the closer the better. It panics as Dijkstra does.
+func AStar[V any, W Weight](graph Graph[V, W], source V, target V, heuristic func(v V) W) (TDAList.List[V], W, bool) {
+	paths := newSearchPaths[V, W](graph, source)
+	if !graph.HasVertex(target) {
+		panic(_PANIC_MESSAGE_VERTEX)
+	}
+	search := newPrioritySearch(graph, source, heuristic(source))
+
+	for !search.isEmpty() {
+		v := search.next()
+		if graph.SameVertex(v, target) {
+			return paths.PathTo(target), paths.Distance(target), true
+		}
+
+		for iter := graph.Adjacent(v).Iterator(); iter.HasNext(); iter.Next() {
+			w := iter.Current()
+			weight := graph.Weight(v, w)
+			if weight < 0 {
+				panic(_PANIC_MESSAGE_NEGATIVE)
+			}
+
+			// A heuristic that is admissible but not consistent may find a shorter path to a settled vertex, which
+			// then goes back to the queue
+			distance := paths.Distance(v) + weight
+			if !paths.Reached(w) || distance < paths.Distance(w) {
+				paths.reach(w, v, distance)
+				search.offer(w, distance+heuristic(w))
+			}
+		}
+	}
+
+	var zero W
+	return TDAList.CreateLinkedList[V](), zero, false
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// prioritySearch is the queue of the vertices reached but not yet settled, keeping a handle to each one so that
+// its priority can be decreased when a better path is found
+type prioritySearch[V any, W Weight] struct {
+	queue   TDAPriorityQueue.AddressableQueue[candidate[V, W]]
+	handles TDADictionary.Dictionary[V, TDAPriorityQueue.Handle[candidate[V, W]]]
+	settled TDADictionary.Dictionary[V, struct{}]
+}
+
+func newPrioritySearch[V any, W Weight](graph Graph[V, W], source V, priority W) *prioritySearch[V, W] {
+	search := &prioritySearch[V, W]{
+		queue: TDAPriorityQueue.NewBinaryHeap(func(a, b candidate[V, W]) int {
+			switch {
+			case a.priority < b.priority:
+				return -1
+			case a.priority > b.priority:
+				return 1
+			}
+			return 0
+		}),
+		handles: TDADictionary.CreateHash[V, TDAPriorityQueue.Handle[candidate[V, W]]](graph.SameVertex),
+		settled: TDADictionary.CreateHash[V, struct{}](graph.SameVertex),
+	}
+	search.offer(source, priority)
+	return search
+}
+
+func (search *prioritySearch[V, W]) isEmpty() bool {
+	return search.queue.IsEmpty()
+}
+
+func (search *prioritySearch[V, W]) isSettled(v V) bool {
+	return search.settled.Belongs(v)
+}
+
+// next removes and returns the queued vertex with the least priority, which becomes settled
+func (search *prioritySearch[V, W]) next() V {
+	v := search.queue.Dequeue().vertex
+	search.handles.Delete(v)
+	search.settled.Save(v, struct{}{})
+	return v
+}
+
+// offer queues the vertex with the priority, or lowers its priority if it is already queued
+func (search *prioritySearch[V, W]) offer(v V, priority W) {
+	if search.handles.Belongs(v) {
+		search.queue.DecreaseKey(search.handles.Get(v), candidate[V, W]{v, priority})
+		return
+	}
+	search.handles.Save(v, search.queue.Insert(candidate[V, W]{v, priority}))
+}
+
+// directedEdges returns the edges of the graph, with the edges of undirected graphs in both directions
+func directedEdges[V any, W Weight](graph Graph[V, W]) []Edge[V, W] {
+	var edges []Edge[V, W]
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		edges = append(edges, edge)
+		if !graph.IsDirected() && !graph.SameVertex(edge.From, edge.To) {
+			edges = append(edges, Edge[V, W]{edge.To, edge.From, edge.Weight})
+		}
+		return true
+	})
+	return edges
+}
+
+// negativeCycle returns the cycle of parents that v leads to, which exists when v was improved in the last round
+// of Bellman-Ford
+func negativeCycle[V any, W Weight](graph Graph[V, W], paths *searchPaths[V, W], v V) TDAList.List[V] {
+	// Going back as many parents as vertices surely ends inside the cycle
+	for i := 0; i < graph.VertexCount(); i++ {
+		v = paths.parents.Get(v)
+	}
+
+	cycle := TDAList.CreateLinkedList[V]()
+	cycle.InsertFirst(v)
+	for u := paths.parents.Get(v); !graph.SameVertex(u, v); u = paths.parents.Get(u) {
+		cycle.InsertFirst(u)
+	}
+	return cycle
+}
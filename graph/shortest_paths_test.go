@@ -0,0 +1,154 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createGrid creates an undirected side x side grid, where vertex r*side+c is joined to its four neighbours
+func createGrid(side int) TDAGraph.Graph[int, int] {
+	graph := TDAGraph.CreateGraph[int, int](intEquality, TDAGraph.Undirected, TDAGraph.Weighted)
+	for v := 0; v < side*side; v++ {
+		graph.AddVertex(v)
+	}
+	for v := 0; v < side*side; v++ {
+		if v%side < side-1 {
+			graph.AddEdge(v, v+1)
+		}
+		if v/side < side-1 {
+			graph.AddEdge(v, v+side)
+		}
+	}
+	return graph
+}
+
+func randomGraph(rng *rand.Rand, vertices int, edges int, minWeight int) TDAGraph.Graph[int, int] {
+	graph := TDAGraph.CreateGraph[int, int](intEquality, TDAGraph.Directed, TDAGraph.Weighted)
+	for v := 0; v < vertices; v++ {
+		graph.AddVertex(v)
+	}
+	for i := 0; i < edges; i++ {
+		graph.AddWeightedEdge(rng.Intn(vertices), rng.Intn(vertices), minWeight+rng.Intn(20))
+	}
+	return graph
+}
+
+// pathLength checks that the path follows edges of the graph and returns the sum of their weights
+func pathLength(t *testing.T, graph TDAGraph.Graph[int, int], path []int) int {
+	length := 0
+	for i := 1; i < len(path); i++ {
+		require.True(t, graph.HasEdge(path[i-1], path[i]))
+		length += graph.Weight(path[i-1], path[i])
+	}
+	return length
+}
+
+func TestDijkstra(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "A", "B", "C", "D", "E")
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 1)
+	graph.AddWeightedEdge("C", "B", 2)
+	graph.AddWeightedEdge("B", "D", 1)
+	graph.AddWeightedEdge("C", "D", 5)
+
+	paths := TDAGraph.Dijkstra(graph, "A")
+	require.EqualValues(t, 3, paths.Distance("B"))
+	require.EqualValues(t, 4, paths.Distance("D"))
+	require.Equal(t, []string{"A", "C", "B", "D"}, toSlice(paths.PathTo("D")))
+	require.False(t, paths.Reached("E"))
+
+	graph.AddWeightedEdge("D", "E", -1)
+	require.PanicsWithValue(t, "The graph has negative weights", func() { TDAGraph.Dijkstra(graph, "A") })
+}
+
+func TestBellmanFord(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "S", "A", "B", "C", "D")
+	graph.AddWeightedEdge("S", "A", 4)
+	graph.AddWeightedEdge("S", "B", 5)
+	graph.AddWeightedEdge("A", "C", 3)
+	graph.AddWeightedEdge("B", "A", -3)
+	graph.AddWeightedEdge("C", "D", 2)
+
+	paths, cycle := TDAGraph.BellmanFord(graph, "S")
+	require.True(t, cycle.IsEmpty())
+	require.EqualValues(t, 2, paths.Distance("A"))
+	require.EqualValues(t, 7, paths.Distance("D"))
+	require.Equal(t, []string{"S", "B", "A", "C", "D"}, toSlice(paths.PathTo("D")))
+
+	graph.AddWeightedEdge("C", "B", -6)
+	paths, cycle = TDAGraph.BellmanFord(graph, "S")
+	require.Nil(t, paths)
+	require.ElementsMatch(t, []string{"A", "C", "B"}, toSlice(cycle))
+
+	vertices := toSlice(cycle)
+	total := 0
+	for i := range vertices {
+		total += graph.Weight(vertices[i], vertices[(i+1)%len(vertices)])
+	}
+	require.Less(t, total, 0, "The cycle is listed in the direction of its edges")
+}
+
+func TestBellmanFordIgnoresUnreachableCycles(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "S", "A", "X", "Y")
+	graph.AddWeightedEdge("S", "A", 1)
+	graph.AddWeightedEdge("X", "Y", -1)
+	graph.AddWeightedEdge("Y", "X", -1)
+
+	paths, cycle := TDAGraph.BellmanFord(graph, "S")
+	require.True(t, cycle.IsEmpty())
+	require.EqualValues(t, 1, paths.Distance("A"))
+	require.False(t, paths.Reached("X"))
+}
+
+func TestShortestPathsAgreeOnRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(43))
+	for i := 0; i < 20; i++ {
+		graph := randomGraph(rng, 60, 300, 0)
+		dijkstra := TDAGraph.Dijkstra(graph, 0)
+		bellmanFord, cycle := TDAGraph.BellmanFord(graph, 0)
+		require.True(t, cycle.IsEmpty())
+
+		for v := 0; v < 60; v++ {
+			require.Equal(t, dijkstra.Reached(v), bellmanFord.Reached(v))
+			if !dijkstra.Reached(v) {
+				continue
+			}
+			require.EqualValues(t, dijkstra.Distance(v), bellmanFord.Distance(v))
+			require.EqualValues(t, dijkstra.Distance(v), pathLength(t, graph, toSlice(dijkstra.PathTo(v))))
+
+			path, length, found := TDAGraph.AStar(graph, 0, v, func(int) int { return 0 })
+			require.True(t, found)
+			require.EqualValues(t, dijkstra.Distance(v), length)
+			require.EqualValues(t, length, pathLength(t, graph, toSlice(path)))
+		}
+	}
+}
+
+func TestAStarOnGrid(t *testing.T) {
+	const side = 40
+	graph := createGrid(side)
+	for r := 1; r < side-1; r++ {
+		graph.RemoveVertex(r*side + side/2)
+	}
+	manhattan := func(target int) func(int) int {
+		return func(v int) int {
+			return max(v/side-target/side, target/side-v/side) + max(v%side-target%side, target%side-v%side)
+		}
+	}
+
+	target := side*side/2 + side - 1
+	dijkstra := TDAGraph.Dijkstra(graph, side*side/2)
+	path, length, found := TDAGraph.AStar(graph, side*side/2, target, manhattan(target))
+	require.True(t, found)
+	require.EqualValues(t, dijkstra.Distance(target), length)
+	require.EqualValues(t, length, pathLength(t, graph, toSlice(path)))
+	require.Greater(t, length, manhattan(target)(side*side/2), "The wall forces a detour")
+
+	graph.AddVertex(-1)
+	path, _, found = TDAGraph.AStar(graph, 0, -1, manhattan(0))
+	require.False(t, found)
+	require.True(t, path.IsEmpty())
+}
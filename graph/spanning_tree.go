@@ -0,0 +1,99 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAUnionFind "adts/unionfind"
+	"slices"
+)
+
+const _PANIC_MESSAGE_DIRECTED = "The graph is not undirected"
+
+// Kruskal finds a minimum spanning forest of an undirected graph, a minimum spanning tree of each of its connected
+// components, by taking the edges from the lightest to the heaviest and keeping those that join two trees. It
+// returns the edges of the forest, in the order they were taken, and their total weight. It panics with the
+// message 'The graph is not undirected' if the graph is directed.
+func Kruskal[V any, W Weight](graph Graph[V, W]) (TDAList.List[Edge[V, W]], W) {
+	checkUndirected(graph)
+	var edges []Edge[V, W]
+	graph.Edges().Iterate(func(edge Edge[V, W]) bool {
+		edges = append(edges, edge)
+		return true
+	})
+	slices.SortStableFunc(edges, func(a, b Edge[V, W]) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		}
+		return 0
+	})
+
+	trees := TDAUnionFind.CreateUnionFind[V](graph.SameVertex)
+	graph.Vertices().Iterate(func(v V) bool {
+		trees.Add(v)
+		return true
+	})
+
+	forest := TDAList.CreateLinkedList[Edge[V, W]]()
+	var total W
+	for _, edge := range edges {
+		if trees.Union(edge.From, edge.To) {
+			forest.InsertLast(edge)
+			total += edge.Weight
+			if trees.Sets() == 1 {
+				break
+			}
+		}
+	}
+	return forest, total
+}
+
+// Prim finds a minimum spanning forest of an undirected graph, a minimum spanning tree of each of its connected
+// components, by growing each tree from one of its vertices with the lightest edge that reaches a new vertex.
+// It returns the edges of the forest, in the order they were taken and from the vertex already in the tree, and
+// their total weight. It panics with the message 'The graph is not undirected' if the graph is directed.
+func Prim[V any, W Weight](graph Graph[V, W]) (TDAList.List[Edge[V, W]], W) {
+	checkUndirected(graph)
+	forest := TDAList.CreateLinkedList[Edge[V, W]]()
+	var total W
+	spanned := TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+
+	for roots := graph.Vertices().Iterator(); roots.HasNext(); roots.Next() {
+		if spanned.Belongs(roots.Current()) {
+			continue
+		}
+
+		// The priority of a queued vertex is the weight of the lightest edge found from the tree to it
+		var zero W
+		search := newPrioritySearch(graph, roots.Current(), zero)
+		lightest := TDADictionary.CreateHash[V, Edge[V, W]](graph.SameVertex)
+		for !search.isEmpty() {
+			v := search.next()
+			spanned.Save(v, struct{}{})
+			if lightest.Belongs(v) {
+				forest.InsertLast(lightest.Get(v))
+				total += lightest.Get(v).Weight
+			}
+
+			for iter := graph.Adjacent(v).Iterator(); iter.HasNext(); iter.Next() {
+				w := iter.Current()
+				weight := graph.Weight(v, w)
+				if !search.isSettled(w) && (!lightest.Belongs(w) || weight < lightest.Get(w).Weight) {
+					lightest.Save(w, Edge[V, W]{v, w, weight})
+					search.offer(w, weight)
+				}
+			}
+		}
+	}
+	return forest, total
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func checkUndirected[V any, W Weight](graph Graph[V, W]) {
+	if graph.IsDirected() {
+		panic(_PANIC_MESSAGE_DIRECTED)
+	}
+}
@@ -0,0 +1,122 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type spanningTreeAlgorithm func(TDAGraph.Graph[string, int]) (TDAList.List[TDAGraph.Edge[string, int]], int)
+
+var SPANNING_TREE_ALGORITHMS = map[string]spanningTreeAlgorithm{
+	"Kruskal": TDAGraph.Kruskal[string, int],
+	"Prim":    TDAGraph.Prim[string, int],
+}
+
+// requireSpanningForest checks that the edges belong to the graph and, with as many edges as the graph has vertices
+// minus components, join every pair of vertices that are connected in the graph
+func requireSpanningForest(t *testing.T, graph TDAGraph.Graph[string, int], forest TDAList.List[TDAGraph.Edge[string, int]], total int) {
+	tree := createGraph(TDAGraph.Undirected, TDAGraph.Weighted)
+	graph.Vertices().Iterate(func(v string) bool {
+		tree.AddVertex(v)
+		return true
+	})
+	sum := 0
+	forest.Iterate(func(edge TDAGraph.Edge[string, int]) bool {
+		require.True(t, graph.HasEdge(edge.From, edge.To))
+		require.EqualValues(t, graph.Weight(edge.From, edge.To), edge.Weight)
+		require.False(t, tree.HasEdge(edge.From, edge.To))
+		tree.AddWeightedEdge(edge.From, edge.To, edge.Weight)
+		sum += edge.Weight
+		return true
+	})
+	require.EqualValues(t, sum, total)
+
+	graph.Vertices().Iterate(func(v string) bool {
+		require.Equal(t, reachable(graph, v), reachable(tree, v), "The forest must span the component of %s", v)
+		return true
+	})
+	components := 0
+	graph.Vertices().Iterate(func(v string) bool {
+		if reachable(graph, v)[0] == v {
+			components++
+		}
+		return true
+	})
+	require.EqualValues(t, graph.VertexCount()-components, forest.Length())
+}
+
+// reachable returns the vertices reached from v, sorted
+func reachable(graph TDAGraph.Graph[string, int], v string) []string {
+	vertices := TDAList.CreateLinkedList[string]()
+	TDAGraph.BFS(graph, v, func(w string) bool {
+		vertices.InsertLast(w)
+		return true
+	})
+	return sorted(vertices)
+}
+
+func TestSpanningTreeDirected(t *testing.T) {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Weighted, "A")
+	for _, algorithm := range SPANNING_TREE_ALGORITHMS {
+		require.PanicsWithValue(t, "The graph is not undirected", func() { algorithm(graph) })
+	}
+}
+
+func TestSpanningTreeEmptyGraph(t *testing.T) {
+	for name, algorithm := range SPANNING_TREE_ALGORITHMS {
+		forest, total := algorithm(createGraph(TDAGraph.Undirected, TDAGraph.Weighted))
+		require.True(t, forest.IsEmpty(), name)
+		require.EqualValues(t, 0, total, name)
+	}
+}
+
+func TestSpanningTreeNetwork(t *testing.T) {
+	// Cities joined by the cost of laying cable between them, with an island that cannot be reached
+	graph := createGraph(TDAGraph.Undirected, TDAGraph.Weighted, "A", "B", "C", "D", "E", "F", "X", "Y")
+	graph.AddWeightedEdge("A", "B", 7)
+	graph.AddWeightedEdge("A", "D", 5)
+	graph.AddWeightedEdge("B", "C", 8)
+	graph.AddWeightedEdge("B", "D", 9)
+	graph.AddWeightedEdge("B", "E", 7)
+	graph.AddWeightedEdge("C", "E", 5)
+	graph.AddWeightedEdge("D", "E", 15)
+	graph.AddWeightedEdge("D", "F", 6)
+	graph.AddWeightedEdge("E", "F", 8)
+	graph.AddWeightedEdge("X", "Y", -2)
+
+	for name, algorithm := range SPANNING_TREE_ALGORITHMS {
+		forest, total := algorithm(graph)
+		require.EqualValues(t, 28, total, name)
+		require.EqualValues(t, 6, forest.Length(), name)
+		requireSpanningForest(t, graph, forest, total)
+	}
+
+	forest, _ := TDAGraph.Kruskal(graph)
+	require.EqualValues(t, -2, forest.PeekFirst().Weight, "Kruskal takes the lightest edge first")
+	require.EqualValues(t, 7, forest.PeekLast().Weight)
+}
+
+func TestSpanningTreeRandomGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	for round := 0; round < 30; round++ {
+		graph := createGraph(TDAGraph.Undirected, TDAGraph.Weighted)
+		n := 30
+		for v := 0; v < n; v++ {
+			graph.AddVertex(fmt.Sprint(v))
+		}
+		for i := 0; i < 45; i++ {
+			graph.AddWeightedEdge(fmt.Sprint(rng.Intn(n)), fmt.Sprint(rng.Intn(n)), rng.Intn(20)-5)
+		}
+
+		kruskal, kruskalTotal := TDAGraph.Kruskal(graph)
+		prim, primTotal := TDAGraph.Prim(graph)
+		require.EqualValues(t, kruskalTotal, primTotal, "Every minimum spanning forest weighs the same")
+		requireSpanningForest(t, graph, kruskal, kruskalTotal)
+		requireSpanningForest(t, graph, prim, primTotal)
+	}
+}
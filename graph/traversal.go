@@ -0,0 +1,81 @@
+package graph
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAQueue "adts/queue"
+	TDAStack "adts/stack"
+)
+
+// BFS traverses the graph breadth first from the source, calling visit, if it is not nil, with every vertex in the
+// order it is reached, until all reachable vertices are visited or visit returns false. The paths it returns
+// have the least possible number of edges. If the source does not belong to the graph, it panics with the
+// message 'The vertex does not belong to the graph'.
+func BFS[V any, W Weight](graph Graph[V, W], source V, visit func(v V) bool) Paths[V, int] {
+	paths := newSearchPaths[V, int](graph, source)
+	if visit != nil && !visit(source) {
+		return paths
+	}
+
+	pending := TDAQueue.NewLinkedQueue[V]()
+	pending.Enqueue(source)
+	for !pending.IsEmpty() {
+		v := pending.Dequeue()
+		for iter := graph.Adjacent(v).Iterator(); iter.HasNext(); iter.Next() {
+			w := iter.Current()
+			if paths.Reached(w) {
+				continue
+			}
+			paths.reach(w, v, paths.Distance(v)+1)
+			if visit != nil && !visit(w) {
+				return paths
+			}
+			pending.Enqueue(w)
+		}
+	}
+	return paths
+}
+
+// dfsFrame is a vertex waiting in the DFS stack, together with the vertex whose edge led to it
+type dfsFrame[V any] struct {
+	vertex    V
+	parent    V
+	hasParent bool
+}
+
+// DFS traverses the graph depth first from the source, calling visit, if it is not nil, with every vertex in
+// preorder, until all reachable vertices are visited or visit returns false. The paths it returns are those of
+// the depth first tree, and their distances are the depths in it. If the source does not belong to the graph, it
+// panics with the message 'The vertex does not belong to the graph'.
+func DFS[V any, W Weight](graph Graph[V, W], source V, visit func(v V) bool) Paths[V, int] {
+	paths := newSearchPaths[V, int](graph, source)
+	visited := TDADictionary.CreateHash[V, struct{}](graph.SameVertex)
+
+	pending := TDAStack.NewDynamicStack[dfsFrame[V]]()
+	pending.Push(dfsFrame[V]{vertex: source})
+	for !pending.IsEmpty() {
+		frame := pending.Pop()
+		if visited.Belongs(frame.vertex) {
+			continue
+		}
+		visited.Save(frame.vertex, struct{}{})
+		if frame.hasParent {
+			paths.reach(frame.vertex, frame.parent, paths.Distance(frame.parent)+1)
+		}
+		if visit != nil && !visit(frame.vertex) {
+			return paths
+		}
+
+		// The neighbours are pushed backwards, so they are explored in the order Adjacent lists them
+		var neighbours []V
+		graph.Adjacent(frame.vertex).Iterate(func(w V) bool {
+			if !visited.Belongs(w) {
+				neighbours = append(neighbours, w)
+			}
+			return true
+		})
+		for i := len(neighbours) - 1; i >= 0; i-- {
+			pending.Push(dfsFrame[V]{neighbours[i], frame.vertex, true})
+		}
+	}
+	return paths
+}
@@ -0,0 +1,109 @@
+package graph_test
+
+import (
+	TDAGraph "adts/graph"
+	TDAList "adts/list"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func toSlice[T any](list TDAList.List[T]) []T {
+	result := []T{}
+	list.Iterate(func(element T) bool {
+		result = append(result, element)
+		return true
+	})
+	return result
+}
+
+// createTreeGraph creates the directed graph A->B, A->D, B->C, D->C, E->A, where E cannot be reached from A
+func createTreeGraph() TDAGraph.Graph[string, int] {
+	graph := createGraph(TDAGraph.Directed, TDAGraph.Unweighted, "A", "B", "C", "D", "E")
+	graph.AddEdge("A", "B")
+	graph.AddEdge("A", "D")
+	graph.AddEdge("B", "C")
+	graph.AddEdge("D", "C")
+	graph.AddEdge("E", "A")
+	return graph
+}
+
+func TestBFS(t *testing.T) {
+	graph := createTreeGraph()
+	var order []string
+	paths := TDAGraph.BFS(graph, "A", func(v string) bool {
+		order = append(order, v)
+		return true
+	})
+
+	require.Len(t, order, 4)
+	require.EqualValues(t, "A", order[0])
+	require.EqualValues(t, "C", order[3], "C is the only vertex two edges away")
+	require.EqualValues(t, "A", paths.Source())
+	require.EqualValues(t, 0, paths.Distance("A"))
+	require.EqualValues(t, 1, paths.Distance("D"))
+	require.EqualValues(t, 2, paths.Distance("C"))
+	require.Len(t, toSlice(paths.PathTo("C")), 3)
+	require.Equal(t, []string{"A"}, toSlice(paths.PathTo("A")))
+
+	require.False(t, paths.Reached("E"))
+	require.PanicsWithValue(t, "The vertex was not reached", func() { paths.Distance("E") })
+	require.PanicsWithValue(t, "The vertex was not reached", func() { paths.PathTo("E") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_VERTEX, func() { TDAGraph.BFS(graph, "Z", nil) })
+}
+
+func TestDFS(t *testing.T) {
+	graph := createTreeGraph()
+	var order []string
+	paths := TDAGraph.DFS(graph, "A", func(v string) bool {
+		order = append(order, v)
+		return true
+	})
+
+	require.Len(t, order, 4)
+	require.EqualValues(t, "A", order[0])
+	first := order[1]
+	require.EqualValues(t, "C", order[2], "C is explored right after the first child of A")
+	require.EqualValues(t, 2, paths.Distance("C"))
+	require.Equal(t, []string{"A", first, "C"}, toSlice(paths.PathTo("C")), "Paths follow the depth first tree")
+	require.False(t, paths.Reached("E"))
+}
+
+func TestTraversalsStopEarly(t *testing.T) {
+	graph := createTreeGraph()
+	for name, traverse := range map[string]func(func(string) bool) TDAGraph.Paths[string, int]{
+		"BFS": func(visit func(string) bool) TDAGraph.Paths[string, int] { return TDAGraph.BFS(graph, "A", visit) },
+		"DFS": func(visit func(string) bool) TDAGraph.Paths[string, int] { return TDAGraph.DFS(graph, "A", visit) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			visited := 0
+			traverse(func(string) bool {
+				visited++
+				return visited < 2
+			})
+			require.EqualValues(t, 2, visited)
+		})
+	}
+}
+
+func TestTraversalsOnLargeGrid(t *testing.T) {
+	const side = 40
+	graph := createGrid(side)
+	bfs := TDAGraph.BFS(graph, 0, nil)
+	dfs := TDAGraph.DFS(graph, 0, nil)
+
+	for v := 0; v < side*side; v++ {
+		require.EqualValues(t, v/side+v%side, bfs.Distance(v), "BFS finds the Manhattan distance in a grid")
+		require.True(t, dfs.Reached(v))
+	}
+
+	for v := 0; v < side*side; v += 97 {
+		path := toSlice(dfs.PathTo(v))
+		require.EqualValues(t, dfs.Distance(v)+1, len(path))
+		for i := 1; i < len(path); i++ {
+			require.True(t, graph.HasEdge(path[i-1], path[i]))
+		}
+		require.False(t, slices.Contains(path[:len(path)-1], v), "Paths do not repeat vertices")
+	}
+}
@@ -0,0 +1,242 @@
+package intervaltree
+
+import (
+	TDAList "adts/list"
+)
+
+const (
+	_PANIC_MESSAGE_INTERVAL = "The interval is not valid"
+	_PANIC_MESSAGE_MISSING  = "The interval does not belong to the tree"
+)
+
+// intervalNode is ordered by lo and then by hi. maxHi is the greatest upper endpoint in its subtree, which lets the
+// queries skip the subtrees that end before the range starts.
+type intervalNode[T, V any] struct {
+	lo     T
+	hi     T
+	value  V
+	maxHi  T
+	left   *intervalNode[T, V]
+	right  *intervalNode[T, V]
+	height int
+}
+
+type avlIntervalTree[T, V any] struct {
+	root  *intervalNode[T, V]
+	count int
+	cmp   func(T, T) int
+}
+
+// CreateIntervalTree creates an empty IntervalTree backed by an AVL tree. cmp(a, b) must be negative if the point a
+// goes before b, zero if they are the same point, and positive otherwise.
+func CreateIntervalTree[T, V any](cmp func(T, T) int) IntervalTree[T, V] {
+	return &avlIntervalTree[T, V]{cmp: cmp}
+}
+
+// ----------------------- TREE PRIMITIVES -----------------------
+
+func (tree *avlIntervalTree[T, V]) Insert(lo T, hi T, value V) {
+	tree.checkInterval(lo, hi)
+	tree.root = tree.insert(tree.root, lo, hi, value)
+}
+
+func (tree *avlIntervalTree[T, V]) Belongs(lo T, hi T) bool {
+	current := tree.root
+	for current != nil {
+		comparison := tree.compareInterval(lo, hi, current)
+		if comparison == 0 {
+			return true
+		}
+		if comparison < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return false
+}
+
+func (tree *avlIntervalTree[T, V]) Delete(lo T, hi T) V {
+	var value V
+	tree.root = tree.remove(tree.root, lo, hi, &value)
+	return value
+}
+
+func (tree *avlIntervalTree[T, V]) Count() int {
+	return tree.count
+}
+
+func (tree *avlIntervalTree[T, V]) Overlapping(lo T, hi T) TDAList.List[Entry[T, V]] {
+	tree.checkInterval(lo, hi)
+	result := TDAList.CreateLinkedList[Entry[T, V]]()
+	tree.overlapping(tree.root, lo, hi, result)
+	return result
+}
+
+func (tree *avlIntervalTree[T, V]) Stabbing(point T) TDAList.List[Entry[T, V]] {
+	return tree.Overlapping(point, point)
+}
+
+func (tree *avlIntervalTree[T, V]) Iterate(visit func(lo T, hi T, value V) bool) {
+	iterate(tree.root, visit)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Tree functions
+
+func (tree *avlIntervalTree[T, V]) checkInterval(lo T, hi T) {
+	if tree.cmp(lo, hi) > 0 {
+		panic(_PANIC_MESSAGE_INTERVAL)
+	}
+}
+
+// compareInterval compares [lo, hi] with the interval of the node, by lower and then upper endpoint
+func (tree *avlIntervalTree[T, V]) compareInterval(lo T, hi T, node *intervalNode[T, V]) int {
+	if comparison := tree.cmp(lo, node.lo); comparison != 0 {
+		return comparison
+	}
+	return tree.cmp(hi, node.hi)
+}
+
+func (tree *avlIntervalTree[T, V]) insert(node *intervalNode[T, V], lo T, hi T, value V) *intervalNode[T, V] {
+	if node == nil {
+		tree.count++
+		return &intervalNode[T, V]{lo: lo, hi: hi, value: value, maxHi: hi, height: 1}
+	}
+
+	comparison := tree.compareInterval(lo, hi, node)
+	switch {
+	case comparison < 0:
+		node.left = tree.insert(node.left, lo, hi, value)
+	case comparison > 0:
+		node.right = tree.insert(node.right, lo, hi, value)
+	default:
+		node.value = value
+		return node
+	}
+	return tree.rebalance(node)
+}
+
+func (tree *avlIntervalTree[T, V]) remove(node *intervalNode[T, V], lo T, hi T, value *V) *intervalNode[T, V] {
+	if node == nil {
+		panic(_PANIC_MESSAGE_MISSING)
+	}
+
+	comparison := tree.compareInterval(lo, hi, node)
+	switch {
+	case comparison < 0:
+		node.left = tree.remove(node.left, lo, hi, value)
+	case comparison > 0:
+		node.right = tree.remove(node.right, lo, hi, value)
+	default:
+		*value = node.value
+		tree.count--
+
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+
+		// The node takes the place of its successor, which is removed from the right subtree
+		var successor *intervalNode[T, V]
+		node.right = tree.removeMin(node.right, &successor)
+		node.lo, node.hi, node.value = successor.lo, successor.hi, successor.value
+	}
+	return tree.rebalance(node)
+}
+
+func (tree *avlIntervalTree[T, V]) removeMin(node *intervalNode[T, V], minNode **intervalNode[T, V]) *intervalNode[T, V] {
+	if node.left == nil {
+		*minNode = node
+		return node.right
+	}
+	node.left = tree.removeMin(node.left, minNode)
+	return tree.rebalance(node)
+}
+
+// overlapping adds, in order, the intervals of the subtree that overlap [lo, hi]. A subtree whose greatest upper
+// endpoint is before lo has nothing to add, and neither do the nodes that start after hi nor their right subtrees.
+func (tree *avlIntervalTree[T, V]) overlapping(node *intervalNode[T, V], lo T, hi T, result TDAList.List[Entry[T, V]]) {
+	if node == nil || tree.cmp(node.maxHi, lo) < 0 {
+		return
+	}
+
+	tree.overlapping(node.left, lo, hi, result)
+	if tree.cmp(node.lo, hi) > 0 {
+		return
+	}
+	if tree.cmp(node.hi, lo) >= 0 {
+		result.InsertLast(Entry[T, V]{node.lo, node.hi, node.value})
+	}
+	tree.overlapping(node.right, lo, hi, result)
+}
+
+func iterate[T, V any](node *intervalNode[T, V], visit func(T, T, V) bool) bool {
+	if node == nil {
+		return true
+	}
+	return iterate(node.left, visit) && visit(node.lo, node.hi, node.value) && iterate(node.right, visit)
+}
+
+// Balancing functions
+
+func height[T, V any](node *intervalNode[T, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+// update recomputes the height and the greatest upper endpoint of the node from its children
+func (tree *avlIntervalTree[T, V]) update(node *intervalNode[T, V]) {
+	node.height = 1 + max(height(node.left), height(node.right))
+	node.maxHi = node.hi
+	for _, child := range []*intervalNode[T, V]{node.left, node.right} {
+		if child != nil && tree.cmp(child.maxHi, node.maxHi) > 0 {
+			node.maxHi = child.maxHi
+		}
+	}
+}
+
+func balanceFactor[T, V any](node *intervalNode[T, V]) int {
+	return height(node.left) - height(node.right)
+}
+
+func (tree *avlIntervalTree[T, V]) rotateRight(node *intervalNode[T, V]) *intervalNode[T, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	tree.update(node)
+	tree.update(pivot)
+	return pivot
+}
+
+func (tree *avlIntervalTree[T, V]) rotateLeft(node *intervalNode[T, V]) *intervalNode[T, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	tree.update(node)
+	tree.update(pivot)
+	return pivot
+}
+
+func (tree *avlIntervalTree[T, V]) rebalance(node *intervalNode[T, V]) *intervalNode[T, V] {
+	tree.update(node)
+
+	switch balance := balanceFactor(node); {
+	case balance > 1:
+		if balanceFactor(node.left) < 0 {
+			node.left = tree.rotateLeft(node.left)
+		}
+		return tree.rotateRight(node)
+	case balance < -1:
+		if balanceFactor(node.right) > 0 {
+			node.right = tree.rotateRight(node.right)
+		}
+		return tree.rotateLeft(node)
+	}
+	return node
+}
@@ -0,0 +1,215 @@
+package intervaltree_test
+
+import (
+	TDAIntervalTree "adts/intervaltree"
+	TDAList "adts/list"
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_MESSAGE_INTERVAL = "The interval is not valid"
+	_PANIC_MESSAGE_MISSING  = "The interval does not belong to the tree"
+)
+
+func values[T, V any](list TDAList.List[TDAIntervalTree.Entry[T, V]]) []V {
+	result := []V{}
+	list.Iterate(func(entry TDAIntervalTree.Entry[T, V]) bool {
+		result = append(result, entry.Value)
+		return true
+	})
+	return result
+}
+
+func TestEmptyIntervalTree(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Belongs(1, 2))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { tree.Delete(1, 2) })
+	require.True(t, tree.Overlapping(0, 100).IsEmpty())
+	require.True(t, tree.Stabbing(5).IsEmpty())
+}
+
+func TestIntervalTreeInvalidIntervals(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INTERVAL, func() { tree.Insert(5, 4, "backwards") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INTERVAL, func() { tree.Overlapping(5, 4) })
+	tree.Insert(4, 4, "point")
+	require.Equal(t, []string{"point"}, values(tree.Stabbing(4)))
+}
+
+func TestIntervalTreeSchedulingConflicts(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	tree.Insert(900, 1000, "standup")
+	tree.Insert(930, 1130, "planning")
+	tree.Insert(1200, 1300, "lunch")
+	tree.Insert(1300, 1400, "review")
+	tree.Insert(1500, 1600, "retro")
+	require.EqualValues(t, 5, tree.Count())
+
+	require.Equal(t, []string{"standup", "planning"}, values(tree.Overlapping(945, 1015)))
+	require.Equal(t, []string{"lunch", "review"}, values(tree.Overlapping(1300, 1300)), "Endpoints are included")
+	require.Empty(t, values(tree.Overlapping(1401, 1459)))
+	require.Equal(t, []string{"planning"}, values(tree.Stabbing(1100)))
+
+	tree.Insert(1300, 1400, "design review")
+	require.EqualValues(t, 5, tree.Count(), "Inserting the same interval replaces its value")
+	require.EqualValues(t, "design review", tree.Delete(1300, 1400))
+	require.False(t, tree.Belongs(1300, 1400))
+	require.True(t, tree.Belongs(1200, 1300))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { tree.Delete(1300, 1400) })
+	require.Equal(t, []string{"lunch"}, values(tree.Stabbing(1300)))
+}
+
+func TestIntervalTreeIPRanges(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[uint32, string](cmp.Compare[uint32])
+	tree.Insert(0x0A000000, 0x0AFFFFFF, "10.0.0.0/8")
+	tree.Insert(0x0A010000, 0x0A01FFFF, "10.1.0.0/16")
+	tree.Insert(0xC0A80000, 0xC0A8FFFF, "192.168.0.0/16")
+
+	require.Equal(t, []string{"10.0.0.0/8", "10.1.0.0/16"}, values(tree.Stabbing(0x0A010203)))
+	require.Equal(t, []string{"10.0.0.0/8"}, values(tree.Stabbing(0x0A020304)))
+	require.Equal(t, []string{"192.168.0.0/16"}, values(tree.Stabbing(0xC0A80101)))
+	require.Empty(t, values(tree.Stabbing(0x08080808)))
+}
+
+func TestIntervalTreeGenericEndpoints(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[string, int](strings.Compare)
+	tree.Insert("apple", "cherry", 1)
+	tree.Insert("banana", "date", 2)
+	tree.Insert("fig", "grape", 3)
+
+	require.Equal(t, []int{1, 2}, values(tree.Stabbing("blueberry")))
+	require.Equal(t, []int{2, 3}, values(tree.Overlapping("coconut", "fig")))
+}
+
+func TestIntervalTreeAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(21))
+	tree := TDAIntervalTree.CreateIntervalTree[int, int](cmp.Compare[int])
+	reference := map[[2]int]int{}
+
+	for i := 0; i < 5000; i++ {
+		lo := rng.Intn(1000)
+		hi := lo + rng.Intn(50)
+		if _, ok := reference[[2]int{lo, hi}]; ok && rng.Intn(2) == 0 {
+			require.EqualValues(t, reference[[2]int{lo, hi}], tree.Delete(lo, hi))
+			delete(reference, [2]int{lo, hi})
+		} else {
+			tree.Insert(lo, hi, i)
+			reference[[2]int{lo, hi}] = i
+		}
+
+		if i%50 == 0 {
+			queryLo := rng.Intn(1050) - 25
+			queryHi := queryLo + rng.Intn(30)
+			var expected [][2]int
+			for interval := range reference {
+				if interval[0] <= queryHi && queryLo <= interval[1] {
+					expected = append(expected, interval)
+				}
+			}
+			sort.Slice(expected, func(a, b int) bool {
+				if expected[a][0] != expected[b][0] {
+					return expected[a][0] < expected[b][0]
+				}
+				return expected[a][1] < expected[b][1]
+			})
+
+			var actual [][2]int
+			tree.Overlapping(queryLo, queryHi).Iterate(func(entry TDAIntervalTree.Entry[int, int]) bool {
+				require.EqualValues(t, reference[[2]int{entry.Lo, entry.Hi}], entry.Value)
+				actual = append(actual, [2]int{entry.Lo, entry.Hi})
+				return true
+			})
+			require.Equal(t, expected, actual)
+		}
+	}
+
+	require.EqualValues(t, len(reference), tree.Count())
+	visited := 0
+	tree.Iterate(func(lo int, hi int, value int) bool {
+		require.EqualValues(t, reference[[2]int{lo, hi}], value)
+		visited++
+		return true
+	})
+	require.EqualValues(t, len(reference), visited)
+}
+
+func TestIntervalTreeFormat(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	require.Equal(t, "intervals{}", fmt.Sprint(tree))
+	tree.Insert(2, 3, "b")
+	tree.Insert(1, 5, "a")
+	require.Equal(t, "intervals{[1, 5]:a [2, 3]:b}", fmt.Sprint(tree))
+	require.Equal(t, `intervaltree.IntervalTree[int, string]{{1, 5, "a"}, {2, 3, "b"}}`, fmt.Sprintf("%#v", tree))
+}
+
+func TestIntervalTreeJSON(t *testing.T) {
+	tree := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	tree.Insert(2, 3, "b")
+	tree.Insert(1, 5, "a")
+	tree.Insert(4, 4, "c")
+	data, err = json.Marshal(tree)
+	require.NoError(t, err)
+	require.Equal(t, `[[1,5,"a"],[2,3,"b"],[4,4,"c"]]`, string(data))
+
+	copied := TDAIntervalTree.CreateIntervalTree[int, string](cmp.Compare[int])
+	copied.Insert(7, 9, "x")
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 3, copied.Count())
+	require.False(t, copied.Belongs(7, 9))
+	require.Equal(t, []string{"a", "b"}, values(copied.Stabbing(3)))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[[1,2]]`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[[1,2,3]]`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[[1,2,"a"],[5,4,"b"]]`), copied), "An interval that is not valid")
+	again, err := json.Marshal(copied)
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(again), "A failed decoding changes nothing")
+}
+
+func TestIntervalTreeBinary(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	tree := TDAIntervalTree.CreateIntervalTree[int, int](cmp.Compare[int])
+	for i := 0; i < 2000; i++ {
+		lo := rng.Intn(10000)
+		tree.Insert(lo, lo+rng.Intn(100), i)
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(tree))
+	data := buffer.Bytes()
+
+	copied := TDAIntervalTree.CreateIntervalTree[int, int](cmp.Compare[int])
+	copied.Insert(-5, -1, -1)
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied))
+	expected, err := json.Marshal(tree)
+	require.NoError(t, err)
+	actual, err := json.Marshal(copied)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(actual))
+	require.Equal(t, values(tree.Overlapping(5000, 5100)), values(copied.Overlapping(5000, 5100)))
+
+	require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(copied))
+	require.Equal(t, tree.Count(), copied.Count(), "A failed read changes nothing")
+
+	reversed := TDAIntervalTree.CreateIntervalTree[int, int](func(a, b int) int { return b - a })
+	binary, err := tree.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+	require.Error(t, reversed.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary), "The intervals are not valid for its cmp")
+	require.EqualValues(t, 0, reversed.Count())
+}
@@ -0,0 +1,64 @@
+package intervaltree
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "intervaltree"
+
+// ----------------------- BINARY PRIMITIVES -----------------------
+
+// WriteTo writes a binary snapshot of the intervals in order, as Entry values encoded with encoding/gob.
+func (tree *avlIntervalTree[T, V]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, tree.count, func(visit func(Entry[T, V]) bool) {
+		tree.Iterate(func(lo T, hi T, value V) bool {
+			return visit(Entry[T, V]{lo, hi, value})
+		})
+	})
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the intervals of the tree with those of a snapshot written by WriteTo, keeping its cmp, and
+// reads nothing past the end of the snapshot. If the snapshot cannot be read, or holds an interval whose lo is
+// greater than its hi, it returns an error without changing the tree.
+func (tree *avlIntervalTree[T, V]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := CreateIntervalTree[T, V](tree.cmp).(*avlIntervalTree[T, V])
+	valid := true
+	err = snapshot.Read(reader, func(entry Entry[T, V]) {
+		if valid = valid && tree.cmp(entry.Lo, entry.Hi) <= 0; valid {
+			read.Insert(entry.Lo, entry.Hi, entry.Value)
+		}
+	})
+	if err == nil && !valid {
+		err = errInterval
+	}
+	if err == nil {
+		*tree = *read
+	}
+	return reader.BytesRead(), err
+}
+
+func (tree *avlIntervalTree[T, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *avlIntervalTree[T, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *avlIntervalTree[T, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *avlIntervalTree[T, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
@@ -0,0 +1,30 @@
+package intervaltree
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ----------------------- FORMAT PRIMITIVES -----------------------
+
+// String formats the intervals in order with their values, like intervals{[1, 5]:a [2, 3]:b}.
+func (tree *avlIntervalTree[T, V]) String() string {
+	texts := make([]string, 0, tree.count)
+	tree.Iterate(func(lo T, hi T, value V) bool {
+		texts = append(texts, fmt.Sprintf("[%v, %v]:%v", lo, hi, value))
+		return true
+	})
+	return "intervals{" + strings.Join(texts, " ") + "}"
+}
+
+// GoString formats the intervals as Go syntax, like intervaltree.IntervalTree[int, string]{{1, 5, "a"}}, where
+// every interval is an Entry.
+func (tree *avlIntervalTree[T, V]) GoString() string {
+	texts := make([]string, 0, tree.count)
+	tree.Iterate(func(lo T, hi T, value V) bool {
+		texts = append(texts, fmt.Sprintf("{%#v, %#v, %#v}", lo, hi, value))
+		return true
+	})
+	return fmt.Sprintf("intervaltree.IntervalTree[%v, %v]{%s}", reflect.TypeFor[T](), reflect.TypeFor[V](), strings.Join(texts, ", "))
+}
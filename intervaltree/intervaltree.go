@@ -0,0 +1,42 @@
+package intervaltree
+
+import (
+	TDAList "adts/list"
+)
+
+// Entry is an interval of the tree, with both endpoints included, and the value associated with it.
+type Entry[T any, V any] struct {
+	Lo    T
+	Hi    T
+	Value V
+}
+
+// IntervalTree stores closed intervals, each with a value, and finds the ones that overlap a given range. Intervals
+// are identified by their endpoints, and every query yields them sorted by their lower and then upper endpoint.
+type IntervalTree[T any, V any] interface {
+
+	// Insert stores the interval [lo, hi] with the value. If the interval is already in the tree, it replaces its
+	// value. If lo is greater than hi, it must panic with the message 'The interval is not valid'.
+	Insert(lo T, hi T, value V)
+
+	// Belongs determines whether the interval [lo, hi] is in the tree or not.
+	Belongs(lo T, hi T) bool
+
+	// Delete removes the interval [lo, hi], returning its value. If the interval is not in the tree, it must panic
+	// with the message 'The interval does not belong to the tree'.
+	Delete(lo T, hi T) V
+
+	// Count returns the number of intervals in the tree.
+	Count() int
+
+	// Overlapping returns a list of the intervals that share at least one point with [lo, hi]. If lo is greater
+	// than hi, it must panic with the message 'The interval is not valid'.
+	Overlapping(lo T, hi T) TDAList.List[Entry[T, V]]
+
+	// Stabbing returns a list of the intervals that contain the point.
+	Stabbing(point T) TDAList.List[Entry[T, V]]
+
+	// Iterate applies the visit function to every interval in order, until all of them are visited or visit
+	// returns false.
+	Iterate(visit func(lo T, hi T, value V) bool)
+}
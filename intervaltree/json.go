@@ -0,0 +1,57 @@
+package intervaltree
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	errTriple   = errors.New("intervaltree: every interval must be an array with its endpoints and its value")
+	errInterval = errors.New("intervaltree: " + _PANIC_MESSAGE_INTERVAL)
+)
+
+// ----------------------- JSON PRIMITIVES -----------------------
+
+// MarshalJSON encodes the intervals in order as a JSON array of [lo, hi, value] arrays.
+func (tree *avlIntervalTree[T, V]) MarshalJSON() ([]byte, error) {
+	triples := make([][3]any, 0, tree.count)
+	tree.Iterate(func(lo T, hi T, value V) bool {
+		triples = append(triples, [3]any{lo, hi, value})
+		return true
+	})
+	return json.Marshal(triples)
+}
+
+// UnmarshalJSON replaces the intervals of the tree with those of a JSON array written by MarshalJSON, keeping its
+// cmp. A JSON null leaves the tree as it is, and an array that cannot be decoded, or that holds an interval whose
+// lo is greater than its hi, returns an error without changing it.
+func (tree *avlIntervalTree[T, V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	read := CreateIntervalTree[T, V](tree.cmp).(*avlIntervalTree[T, V])
+	for _, element := range raw {
+		var triple []json.RawMessage
+		if err := json.Unmarshal(element, &triple); err != nil || len(triple) != 3 {
+			return errTriple
+		}
+		var entry Entry[T, V]
+		for i, field := range []any{&entry.Lo, &entry.Hi, &entry.Value} {
+			if err := json.Unmarshal(triple[i], field); err != nil {
+				return err
+			}
+		}
+		if tree.cmp(entry.Lo, entry.Hi) > 0 {
+			return errInterval
+		}
+		read.Insert(entry.Lo, entry.Hi, entry.Value)
+	}
+	*tree = *read
+	return nil
+}
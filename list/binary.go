@@ -0,0 +1,50 @@
+package list
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "list"
+
+// WriteTo writes a binary snapshot of the list, from its first element to its last, encoding the elements with
+// encoding/gob.
+func (list *linkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, list.size, list.Iterate)
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the elements of the list with those of a snapshot written by WriteTo, reading nothing past its
+// end. If the snapshot cannot be read, it returns an error without changing the list.
+func (list *linkedList[T]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := &linkedList[T]{}
+	if err := snapshot.Read(reader, read.InsertLast); err != nil {
+		return reader.BytesRead(), err
+	}
+	*list = *read
+	return reader.BytesRead(), nil
+}
+
+func (list *linkedList[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(list)
+}
+
+func (list *linkedList[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, list)
+}
+
+func (list *linkedList[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(list)
+}
+
+func (list *linkedList[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, list)
+}
@@ -0,0 +1,25 @@
+package list
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// String formats the list as fmt formats slices, from its first element to its last: [1 2 3].
+func (list *linkedList[T]) String() string {
+	return "[" + list.join("%v", " ") + "]"
+}
+
+// GoString formats the list as Go syntax, like list.LinkedList[int]{1, 2, 3}.
+func (list *linkedList[T]) GoString() string {
+	return fmt.Sprintf("list.LinkedList[%v]{%s}", reflect.TypeFor[T](), list.join("%#v", ", "))
+}
+
+func (list *linkedList[T]) join(verb string, separator string) string {
+	texts := make([]string, 0, list.size)
+	for current := list.first; current != nil; current = current.next {
+		texts = append(texts, fmt.Sprintf(verb, current.data))
+	}
+	return strings.Join(texts, separator)
+}
@@ -0,0 +1,33 @@
+package list
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the list as a JSON array, from its first element to its last.
+func (list *linkedList[T]) MarshalJSON() ([]byte, error) {
+	elements := make([]T, 0, list.size)
+	for current := list.first; current != nil; current = current.next {
+		elements = append(elements, current.data)
+	}
+	return json.Marshal(elements)
+}
+
+// UnmarshalJSON replaces the elements of the list with those of a JSON array, in the same order. A JSON null
+// leaves the list as it is, and an array that cannot be decoded returns an error without changing it.
+func (list *linkedList[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	list.first, list.last, list.size = nil, nil, 0
+	for _, element := range elements {
+		list.InsertLast(element)
+	}
+	return nil
+}
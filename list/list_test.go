@@ -2,6 +2,10 @@ package list_test
 
 import (
 	ListModule "adts/list"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -373,3 +377,81 @@ func TestIteratorRemoveLast(t *testing.T) {
 	require.Equal(t, 100, list.PeekLast())
 	require.Equal(t, 1, list.Length())
 }
+
+// -------------------- JSON TESTS ------------------------------------
+
+// Test the list is written as an array in order and read back
+func TestListJSON(t *testing.T) {
+	list := ListModule.CreateLinkedList[string]()
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	list.InsertLast("b")
+	list.InsertLast("c")
+	list.InsertFirst("a")
+	data, err = json.Marshal(list)
+	require.NoError(t, err)
+	require.Equal(t, `["a","b","c"]`, string(data))
+
+	copied := ListModule.CreateLinkedList[string]()
+	copied.InsertLast("z")
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.Equal(t, 3, copied.Length())
+	require.Equal(t, "a", copied.PeekFirst())
+	require.Equal(t, "c", copied.PeekLast())
+	copied.InsertLast("d")
+	require.Equal(t, "d", copied.PeekLast())
+}
+
+// Test null leaves the list as it is and invalid input is rejected
+func TestListJSONNullAndInvalid(t *testing.T) {
+	list := ListModule.CreateLinkedList[int]()
+	list.InsertLast(1)
+	require.NoError(t, json.Unmarshal([]byte(`null`), list))
+	require.Error(t, json.Unmarshal([]byte(`{"a":1}`), list))
+	require.Error(t, json.Unmarshal([]byte(`[1,"two"]`), list))
+	require.Equal(t, 1, list.Length())
+	require.Equal(t, 1, list.PeekFirst())
+}
+
+// -------------------- BINARY TESTS ----------------------------------
+
+// Test the list is written in order and read back, with gob as well
+func TestListBinary(t *testing.T) {
+	list := ListModule.CreateLinkedList[int]()
+	for i := 0; i < 3000; i++ {
+		list.InsertLast(i)
+	}
+	data, err := list.(interface{ MarshalBinary() ([]byte, error) }).MarshalBinary()
+	require.NoError(t, err)
+
+	copied := ListModule.CreateLinkedList[int]()
+	copied.InsertLast(-1)
+	require.NoError(t, copied.(interface{ UnmarshalBinary([]byte) error }).UnmarshalBinary(data))
+	require.Equal(t, 3000, copied.Length())
+	i := 0
+	copied.Iterate(func(element int) bool {
+		require.Equal(t, i, element)
+		i++
+		return true
+	})
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(copied))
+	require.Error(t, gob.NewDecoder(bytes.NewReader(buffer.Bytes()[:buffer.Len()/2])).Decode(list))
+	require.Equal(t, 3000, list.Length())
+}
+
+// -------------------- FORMAT TESTS ----------------------------------
+
+// Test the list is printed as a slice, and as Go syntax with %#v
+func TestListFormat(t *testing.T) {
+	list := ListModule.CreateLinkedList[string]()
+	require.Equal(t, "[]", fmt.Sprint(list))
+	list.InsertLast("b")
+	list.InsertLast("c")
+	list.InsertFirst("a")
+	require.Equal(t, "[a b c]", fmt.Sprint(list))
+	require.Equal(t, `list.LinkedList[string]{"a", "b", "c"}`, fmt.Sprintf("%#v", list))
+}
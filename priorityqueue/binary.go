@@ -0,0 +1,156 @@
+package priorityqueue
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "priorityqueue"
+
+// The heaps write binary snapshots of their elements in the order they are stored, which is faster than the
+// order they leave the heap, encoding them with encoding/gob. Reading a snapshot enqueues its elements into an
+// empty heap with the same cmp, reading nothing past the end of the snapshot: if it cannot be read, it returns an
+// error without changing the heap, and otherwise it invalidates the handles the heap had given out.
+
+// ------------ BINARY ENCODING ------------ //
+
+func (heap *binaryHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeHeap(w, heap.size, func(visit func(T) bool) {
+		for _, element := range heap.data[:heap.size] {
+			if !visit(element.value) {
+				return
+			}
+		}
+	})
+}
+
+func (heap *binaryHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	read := NewBinaryHeap(heap.cmp).(*binaryHeap[T])
+	n, err := readHeap[T](r, read)
+	if err == nil {
+		*heap = *read
+	}
+	return n, err
+}
+
+func (heap *fibonacciHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeHeap(w, heap.count, func(visit func(T) bool) {
+		var walk func(first *fibonacciNode[T]) bool
+		walk = func(first *fibonacciNode[T]) bool {
+			if first == nil {
+				return true
+			}
+			for node := first; ; {
+				if !visit(node.value) || !walk(node.child) {
+					return false
+				}
+				if node = node.right; node == first {
+					return true
+				}
+			}
+		}
+		walk(heap.min)
+	})
+}
+
+func (heap *fibonacciHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	read := NewFibonacciHeap(heap.cmp).(*fibonacciHeap[T])
+	n, err := readHeap[T](r, read)
+	if err == nil {
+		*heap = *read
+	}
+	return n, err
+}
+
+func (heap *pairingHeap[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeHeap(w, heap.count, func(visit func(T) bool) {
+		var walk func(node *pairingNode[T]) bool
+		walk = func(node *pairingNode[T]) bool {
+			for ; node != nil; node = node.sibling {
+				if !visit(node.value) || !walk(node.child) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(heap.root)
+	})
+}
+
+func (heap *pairingHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	read := NewPairingHeap(heap.cmp).(*pairingHeap[T])
+	n, err := readHeap[T](r, read)
+	if err == nil {
+		*heap = *read
+	}
+	return n, err
+}
+
+func (heap *binaryHeap[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *binaryHeap[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+func (heap *binaryHeap[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *binaryHeap[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+func (heap *fibonacciHeap[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *fibonacciHeap[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+func (heap *fibonacciHeap[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *fibonacciHeap[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+func (heap *pairingHeap[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *pairingHeap[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+func (heap *pairingHeap[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(heap)
+}
+
+func (heap *pairingHeap[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, heap)
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+func writeHeap[T any](w io.Writer, count int, iterate func(func(T) bool)) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, count, iterate)
+	return writer.BytesWritten(), err
+}
+
+// readHeap enqueues the elements of the snapshot into the empty heap.
+func readHeap[T any](r io.Reader, heap PriorityQueue[T]) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Read(reader, heap.Enqueue)
+	return reader.BytesRead(), err
+}
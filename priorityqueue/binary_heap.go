@@ -0,0 +1,140 @@
+package priorityqueue
+
+const (
+	_PANIC_EMPTY_MSG    = "The priority queue is empty"
+	_PANIC_KEY_MSG      = "The new key is greater than the current key"
+	_PANIC_HANDLE_MSG   = "The handle does not belong to the priority queue"
+	_PANIC_MELD_MSG     = "The priority queues cannot be melded"
+	_HEAP_INITIAL_SIZE  = 10
+	_HEAP_RESIZE_FACTOR = 2
+	_HEAP_SHRINK_FACTOR = 4
+)
+
+type heapElement[T any] struct {
+	value T
+	index int
+}
+
+type binaryHeap[T any] struct {
+	data []*heapElement[T]
+	size int
+	cmp  func(T, T) int
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE HEAP ------------ //
+
+// NewBinaryHeap creates an array-based binary heap ordered by cmp.
+func NewBinaryHeap[T any](cmp func(T, T) int) AddressableQueue[T] {
+	return &binaryHeap[T]{data: make([]*heapElement[T], _HEAP_INITIAL_SIZE), cmp: cmp}
+}
+
+// ------------ PRIORITY QUEUE PRIMITIVES ------------ //
+
+func (heap *binaryHeap[T]) IsEmpty() bool {
+	return heap.size == 0
+}
+
+func (heap *binaryHeap[T]) Enqueue(element T) {
+	heap.Insert(element)
+}
+
+func (heap *binaryHeap[T]) Peek() T {
+	if heap.IsEmpty() {
+		panic(_PANIC_EMPTY_MSG)
+	}
+	return heap.data[0].value
+}
+
+func (heap *binaryHeap[T]) Dequeue() T {
+	top := heap.Peek()
+
+	heap.size--
+	heap.swap(0, heap.size)
+	heap.data[heap.size] = nil
+	heap.downHeap(0)
+
+	if heap.size*_HEAP_SHRINK_FACTOR <= len(heap.data) && len(heap.data) > _HEAP_INITIAL_SIZE {
+		heap.resize(len(heap.data) / _HEAP_RESIZE_FACTOR)
+	}
+
+	return top
+}
+
+func (heap *binaryHeap[T]) Count() int {
+	return heap.size
+}
+
+func (heap *binaryHeap[T]) Insert(element T) Handle[T] {
+	if heap.size == len(heap.data) {
+		heap.resize(len(heap.data) * _HEAP_RESIZE_FACTOR)
+	}
+
+	elem := &heapElement[T]{value: element, index: heap.size}
+	heap.data[heap.size] = elem
+	heap.size++
+	heap.upHeap(elem.index)
+
+	return elem
+}
+
+func (heap *binaryHeap[T]) DecreaseKey(handle Handle[T], element T) {
+	elem, ok := handle.(*heapElement[T])
+	if !ok || elem.index >= heap.size || heap.data[elem.index] != elem {
+		panic(_PANIC_HANDLE_MSG)
+	}
+	if heap.cmp(element, elem.value) > 0 {
+		panic(_PANIC_KEY_MSG)
+	}
+
+	elem.value = element
+	heap.upHeap(elem.index)
+}
+
+func (elem *heapElement[T]) Value() T {
+	return elem.value
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+func (heap *binaryHeap[T]) upHeap(pos int) {
+	for pos > 0 {
+		parent := (pos - 1) / 2
+		if heap.cmp(heap.data[pos].value, heap.data[parent].value) >= 0 {
+			return
+		}
+		heap.swap(pos, parent)
+		pos = parent
+	}
+}
+
+func (heap *binaryHeap[T]) downHeap(pos int) {
+	for {
+		smallest := pos
+		left, right := 2*pos+1, 2*pos+2
+
+		if left < heap.size && heap.cmp(heap.data[left].value, heap.data[smallest].value) < 0 {
+			smallest = left
+		}
+		if right < heap.size && heap.cmp(heap.data[right].value, heap.data[smallest].value) < 0 {
+			smallest = right
+		}
+		if smallest == pos {
+			return
+		}
+
+		heap.swap(pos, smallest)
+		pos = smallest
+	}
+}
+
+func (heap *binaryHeap[T]) swap(i, j int) {
+	heap.data[i], heap.data[j] = heap.data[j], heap.data[i]
+	heap.data[i].index = i
+	heap.data[j].index = j
+}
+
+func (heap *binaryHeap[T]) resize(newCapacity int) {
+	newData := make([]*heapElement[T], newCapacity)
+	copy(newData, heap.data[:heap.size])
+	heap.data = newData
+}
@@ -0,0 +1,244 @@
+package priorityqueue
+
+// fibonacciNode belongs to a circular doubly linked list of siblings (left, right). The roots of the
+// heap form one of those lists.
+type fibonacciNode[T any] struct {
+	value   T
+	parent  *fibonacciNode[T]
+	child   *fibonacciNode[T]
+	left    *fibonacciNode[T]
+	right   *fibonacciNode[T]
+	degree  int
+	marked  bool
+	removed bool
+}
+
+type fibonacciHeap[T any] struct {
+	min   *fibonacciNode[T]
+	count int
+	cmp   func(T, T) int
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE HEAP ------------ //
+
+// NewFibonacciHeap creates a Fibonacci heap ordered by cmp. Enqueue, Meld and DecreaseKey take
+// O(1) amortized time, and Dequeue O(log n) amortized.
+func NewFibonacciHeap[T any](cmp func(T, T) int) MeldableQueue[T] {
+	return &fibonacciHeap[T]{cmp: cmp}
+}
+
+// ------------ PRIORITY QUEUE PRIMITIVES ------------ //
+
+func (heap *fibonacciHeap[T]) IsEmpty() bool {
+	return heap.min == nil
+}
+
+func (heap *fibonacciHeap[T]) Enqueue(element T) {
+	heap.Insert(element)
+}
+
+func (heap *fibonacciHeap[T]) Peek() T {
+	if heap.IsEmpty() {
+		panic(_PANIC_EMPTY_MSG)
+	}
+	return heap.min.value
+}
+
+func (heap *fibonacciHeap[T]) Dequeue() T {
+	top := heap.Peek()
+	oldMin := heap.min
+
+	for oldMin.child != nil {
+		child := oldMin.child
+		oldMin.child = removeFromList(child)
+		child.parent = nil
+		child.marked = false
+		heap.addRoot(child)
+	}
+
+	next := removeFromList(oldMin)
+	heap.min = next
+	heap.count--
+	oldMin.removed = true
+
+	if next != nil {
+		heap.consolidate()
+	}
+
+	return top
+}
+
+func (heap *fibonacciHeap[T]) Count() int {
+	return heap.count
+}
+
+func (heap *fibonacciHeap[T]) Insert(element T) Handle[T] {
+	node := &fibonacciNode[T]{value: element}
+	heap.addRoot(node)
+	heap.count++
+	return node
+}
+
+func (heap *fibonacciHeap[T]) DecreaseKey(handle Handle[T], element T) {
+	node, ok := handle.(*fibonacciNode[T])
+	if !ok || node.removed {
+		panic(_PANIC_HANDLE_MSG)
+	}
+	if heap.cmp(element, node.value) > 0 {
+		panic(_PANIC_KEY_MSG)
+	}
+
+	node.value = element
+	parent := node.parent
+	if parent != nil && heap.cmp(node.value, parent.value) < 0 {
+		heap.cut(node, parent)
+		heap.cascadingCut(parent)
+	}
+	if heap.cmp(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+}
+
+func (heap *fibonacciHeap[T]) Meld(other MeldableQueue[T]) {
+	otherHeap, ok := other.(*fibonacciHeap[T])
+	if !ok {
+		panic(_PANIC_MELD_MSG)
+	}
+	if otherHeap == heap || otherHeap.min == nil {
+		return
+	}
+
+	if heap.min == nil {
+		heap.min = otherHeap.min
+	} else {
+		splice(heap.min, otherHeap.min)
+		if heap.cmp(otherHeap.min.value, heap.min.value) < 0 {
+			heap.min = otherHeap.min
+		}
+	}
+	heap.count += otherHeap.count
+
+	otherHeap.min = nil
+	otherHeap.count = 0
+}
+
+func (node *fibonacciNode[T]) Value() T {
+	return node.value
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// addRoot inserts a single node into the root list, updating the minimum.
+func (heap *fibonacciHeap[T]) addRoot(node *fibonacciNode[T]) {
+	node.left, node.right = node, node
+	if heap.min == nil {
+		heap.min = node
+		return
+	}
+
+	splice(heap.min, node)
+	if heap.cmp(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+}
+
+// consolidate links roots of equal degree until every root has a distinct degree.
+func (heap *fibonacciHeap[T]) consolidate() {
+	var roots []*fibonacciNode[T]
+	start := heap.min
+	for current := start; ; {
+		roots = append(roots, current)
+		current = current.right
+		if current == start {
+			break
+		}
+	}
+
+	var byDegree []*fibonacciNode[T]
+	for _, root := range roots {
+		root.left, root.right = root, root
+		for root.degree < len(byDegree) && byDegree[root.degree] != nil {
+			other := byDegree[root.degree]
+			byDegree[root.degree] = nil
+			if heap.cmp(other.value, root.value) < 0 {
+				root, other = other, root
+			}
+			heap.link(other, root)
+		}
+		for root.degree >= len(byDegree) {
+			byDegree = append(byDegree, nil)
+		}
+		byDegree[root.degree] = root
+	}
+
+	heap.min = nil
+	for _, root := range byDegree {
+		if root != nil {
+			heap.addRoot(root)
+		}
+	}
+}
+
+// link makes child, an isolated root, a child of parent.
+func (heap *fibonacciHeap[T]) link(child, parent *fibonacciNode[T]) {
+	child.parent = parent
+	child.marked = false
+	if parent.child == nil {
+		parent.child = child
+	} else {
+		splice(parent.child, child)
+	}
+	parent.degree++
+}
+
+// cut moves node from the children of parent to the root list.
+func (heap *fibonacciHeap[T]) cut(node, parent *fibonacciNode[T]) {
+	if parent.child == node {
+		parent.child = removeFromList(node)
+	} else {
+		removeFromList(node)
+	}
+	parent.degree--
+
+	node.parent = nil
+	node.marked = false
+	heap.addRoot(node)
+}
+
+// cascadingCut walks up from node, cutting every ancestor that had already lost a child.
+func (heap *fibonacciHeap[T]) cascadingCut(node *fibonacciNode[T]) {
+	for node.parent != nil {
+		if !node.marked {
+			node.marked = true
+			return
+		}
+		parent := node.parent
+		heap.cut(node, parent)
+		node = parent
+	}
+}
+
+// splice joins the circular lists that contain first and second.
+func splice[T any](first, second *fibonacciNode[T]) {
+	firstRight := first.right
+	secondLeft := second.left
+
+	first.right = second
+	second.left = first
+	secondLeft.right = firstRight
+	firstRight.left = secondLeft
+}
+
+// removeFromList unlinks node from its circular list, leaving it as a list of its own, and returns
+// another node of the list, or nil if node was the only one.
+func removeFromList[T any](node *fibonacciNode[T]) *fibonacciNode[T] {
+	if node.right == node {
+		return nil
+	}
+
+	next := node.right
+	node.left.right = node.right
+	node.right.left = node.left
+	node.left, node.right = node, node
+	return next
+}
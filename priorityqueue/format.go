@@ -0,0 +1,102 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// The heaps are formatted with their elements in the order Dequeue would return them, like PriorityQueue[top: 1, 3, 5],
+// so that the text does not depend on the shape of the heap.
+
+// ------------ FORMATTING ------------ //
+
+func (heap *binaryHeap[T]) String() string {
+	return formatInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *binaryHeap[T]) GoString() string {
+	return goFormatInOrder("priorityqueue.BinaryHeap", heap.elements(), heap.cmp)
+}
+
+func (heap *fibonacciHeap[T]) String() string {
+	return formatInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *fibonacciHeap[T]) GoString() string {
+	return goFormatInOrder("priorityqueue.FibonacciHeap", heap.elements(), heap.cmp)
+}
+
+func (heap *pairingHeap[T]) String() string {
+	return formatInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *pairingHeap[T]) GoString() string {
+	return goFormatInOrder("priorityqueue.PairingHeap", heap.elements(), heap.cmp)
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// elements returns a new slice with the elements of the heap, in the order they are stored.
+func (heap *binaryHeap[T]) elements() []T {
+	elements := make([]T, heap.size)
+	for i, element := range heap.data[:heap.size] {
+		elements[i] = element.value
+	}
+	return elements
+}
+
+func (heap *fibonacciHeap[T]) elements() []T {
+	elements := make([]T, 0, heap.count)
+	var collect func(first *fibonacciNode[T])
+	collect = func(first *fibonacciNode[T]) {
+		if first == nil {
+			return
+		}
+		node := first
+		for {
+			elements = append(elements, node.value)
+			collect(node.child)
+			if node = node.right; node == first {
+				return
+			}
+		}
+	}
+	collect(heap.min)
+	return elements
+}
+
+func (heap *pairingHeap[T]) elements() []T {
+	elements := make([]T, 0, heap.count)
+	var collect func(node *pairingNode[T])
+	collect = func(node *pairingNode[T]) {
+		for ; node != nil; node = node.sibling {
+			elements = append(elements, node.value)
+			collect(node.child)
+		}
+	}
+	collect(heap.root)
+	return elements
+}
+
+func formatInOrder[T any](elements []T, cmp func(T, T) int) string {
+	if len(elements) == 0 {
+		return "PriorityQueue[]"
+	}
+	return "PriorityQueue[top: " + joinInOrder(elements, cmp, "%v") + "]"
+}
+
+func goFormatInOrder[T any](name string, elements []T, cmp func(T, T) int) string {
+	return fmt.Sprintf("%s[%v]{%s}", name, reflect.TypeFor[T](), joinInOrder(elements, cmp, "%#v"))
+}
+
+// joinInOrder formats the elements sorted from the highest priority to the lowest, as marshalInOrder encodes them.
+func joinInOrder[T any](elements []T, cmp func(T, T) int, verb string) string {
+	slices.SortStableFunc(elements, cmp)
+	texts := make([]string, len(elements))
+	for i, element := range elements {
+		texts[i] = fmt.Sprintf(verb, element)
+	}
+	return strings.Join(texts, ", ")
+}
@@ -0,0 +1,70 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// The heaps are encoded as a JSON array of their elements in the order Dequeue would return them, so that the
+// encoding does not depend on the shape of the heap. Decoding an array enqueues its elements into an empty heap
+// with the same cmp: a JSON null leaves the heap as it is, an array that cannot be decoded returns an error
+// without changing it, and any other decoded value invalidates the handles the heap had given out.
+
+// ------------ JSON ENCODING ------------ //
+
+func (heap *binaryHeap[T]) MarshalJSON() ([]byte, error) {
+	return marshalInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *binaryHeap[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, heap, func() {
+		heap.data, heap.size = make([]*heapElement[T], _HEAP_INITIAL_SIZE), 0
+	})
+}
+
+func (heap *fibonacciHeap[T]) MarshalJSON() ([]byte, error) {
+	return marshalInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *fibonacciHeap[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, heap, func() {
+		heap.min, heap.count = nil, 0
+	})
+}
+
+func (heap *pairingHeap[T]) MarshalJSON() ([]byte, error) {
+	return marshalInOrder(heap.elements(), heap.cmp)
+}
+
+func (heap *pairingHeap[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, heap, func() {
+		heap.root, heap.count = nil, 0
+	})
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// marshalInOrder encodes the elements sorted from the highest priority to the lowest, keeping the relative
+// order of elements of equal priority.
+func marshalInOrder[T any](elements []T, cmp func(T, T) int) ([]byte, error) {
+	slices.SortStableFunc(elements, cmp)
+	return json.Marshal(elements)
+}
+
+// unmarshalInto decodes a JSON array and, if it succeeds, empties the heap with reset and enqueues every element.
+func unmarshalInto[T any](data []byte, heap PriorityQueue[T], reset func()) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	reset()
+	for _, element := range elements {
+		heap.Enqueue(element)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package priorityqueue
+
+// pairingNode keeps its children as a singly linked list of siblings. prev points to the parent
+// when the node is the leftmost child, and to the left sibling otherwise.
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+	prev    *pairingNode[T]
+	removed bool
+}
+
+type pairingHeap[T any] struct {
+	root  *pairingNode[T]
+	count int
+	cmp   func(T, T) int
+}
+
+// ------------ FUNCTION TO CREATE AND RETURN THE HEAP ------------ //
+
+// NewPairingHeap creates a two-pass pairing heap ordered by cmp. Enqueue, Meld and DecreaseKey take
+// O(1) amortized time, and Dequeue O(log n) amortized.
+func NewPairingHeap[T any](cmp func(T, T) int) MeldableQueue[T] {
+	return &pairingHeap[T]{cmp: cmp}
+}
+
+// ------------ PRIORITY QUEUE PRIMITIVES ------------ //
+
+func (heap *pairingHeap[T]) IsEmpty() bool {
+	return heap.root == nil
+}
+
+func (heap *pairingHeap[T]) Enqueue(element T) {
+	heap.Insert(element)
+}
+
+func (heap *pairingHeap[T]) Peek() T {
+	if heap.IsEmpty() {
+		panic(_PANIC_EMPTY_MSG)
+	}
+	return heap.root.value
+}
+
+func (heap *pairingHeap[T]) Dequeue() T {
+	top := heap.Peek()
+	oldRoot := heap.root
+
+	heap.root = heap.mergePairs(oldRoot.child)
+	heap.count--
+
+	oldRoot.child = nil
+	oldRoot.removed = true
+
+	return top
+}
+
+func (heap *pairingHeap[T]) Count() int {
+	return heap.count
+}
+
+func (heap *pairingHeap[T]) Insert(element T) Handle[T] {
+	node := &pairingNode[T]{value: element}
+	heap.root = heap.link(heap.root, node)
+	heap.count++
+	return node
+}
+
+func (heap *pairingHeap[T]) DecreaseKey(handle Handle[T], element T) {
+	node, ok := handle.(*pairingNode[T])
+	if !ok || node.removed {
+		panic(_PANIC_HANDLE_MSG)
+	}
+	if heap.cmp(element, node.value) > 0 {
+		panic(_PANIC_KEY_MSG)
+	}
+
+	node.value = element
+	if node == heap.root {
+		return
+	}
+
+	heap.detach(node)
+	heap.root = heap.link(heap.root, node)
+}
+
+func (heap *pairingHeap[T]) Meld(other MeldableQueue[T]) {
+	otherHeap, ok := other.(*pairingHeap[T])
+	if !ok {
+		panic(_PANIC_MELD_MSG)
+	}
+	if otherHeap == heap || otherHeap.root == nil {
+		return
+	}
+
+	heap.root = heap.link(heap.root, otherHeap.root)
+	heap.count += otherHeap.count
+
+	otherHeap.root = nil
+	otherHeap.count = 0
+}
+
+func (node *pairingNode[T]) Value() T {
+	return node.value
+}
+
+// ------------ INTERNAL HELPER METHODS ------------ //
+
+// link joins two heap-ordered trees, making the root with lower priority the leftmost child of the other.
+func (heap *pairingHeap[T]) link(first, second *pairingNode[T]) *pairingNode[T] {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	if heap.cmp(second.value, first.value) < 0 {
+		first, second = second, first
+	}
+
+	second.sibling = first.child
+	if first.child != nil {
+		first.child.prev = second
+	}
+	second.prev = first
+	first.child = second
+	first.sibling = nil
+	first.prev = nil
+
+	return first
+}
+
+// mergePairs links the siblings pairwise from left to right, and then folds the resulting trees
+// from right to left.
+func (heap *pairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var trees []*pairingNode[T]
+	for current := first; current != nil; {
+		next := current.sibling
+		current.sibling = nil
+		current.prev = nil
+
+		if next == nil {
+			trees = append(trees, current)
+			break
+		}
+
+		following := next.sibling
+		next.sibling = nil
+		next.prev = nil
+
+		trees = append(trees, heap.link(current, next))
+		current = following
+	}
+
+	var root *pairingNode[T]
+	for i := len(trees) - 1; i >= 0; i-- {
+		root = heap.link(trees[i], root)
+	}
+	return root
+}
+
+// detach cuts the subtree rooted at node out of its parent's children.
+func (heap *pairingHeap[T]) detach(node *pairingNode[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+
+	node.prev = nil
+	node.sibling = nil
+}
@@ -0,0 +1,52 @@
+package priorityqueue
+
+// PriorityQueue represents an abstract data type for a priority queue. The order is given by a comparison
+// function cmp: cmp(a, b) < 0 means that a has higher priority than b and leaves the queue first.
+type PriorityQueue[T any] interface {
+	// IsEmpty returns true if the priority queue has no elements, false otherwise.
+	IsEmpty() bool
+
+	// Enqueue adds a new element to the priority queue.
+	Enqueue(T)
+
+	// Peek returns the element with the highest priority.
+	// If the priority queue is empty, it panics with "The priority queue is empty".
+	Peek() T
+
+	// Dequeue removes and returns the element with the highest priority.
+	// If the priority queue is empty, it panics with "The priority queue is empty".
+	Dequeue() T
+
+	// Count returns the number of elements in the priority queue.
+	Count() int
+}
+
+// Handle identifies an element stored in an AddressableQueue, so that its key can be changed later on.
+type Handle[T any] interface {
+	// Value returns the element the handle refers to.
+	Value() T
+}
+
+// AddressableQueue is a PriorityQueue whose elements can be reached through a Handle.
+type AddressableQueue[T any] interface {
+	PriorityQueue[T]
+
+	// Insert adds a new element to the priority queue and returns a Handle to it.
+	Insert(T) Handle[T]
+
+	// DecreaseKey replaces the element referenced by the handle with a new one of equal or higher priority.
+	// If the new element has lower priority, it panics with "The new key is greater than the current key".
+	// If the element was already dequeued, it panics with "The handle does not belong to the priority queue".
+	// Pre: The handle was returned by this queue, or by a queue melded into it.
+	DecreaseKey(Handle[T], T)
+}
+
+// MeldableQueue is an AddressableQueue that can absorb another queue of the same implementation.
+type MeldableQueue[T any] interface {
+	AddressableQueue[T]
+
+	// Meld moves every element of other into this queue, leaving other empty. Handles to the elements of
+	// other remain valid and now refer to this queue.
+	// If other is not of the same implementation, it panics with "The priority queues cannot be melded".
+	Meld(other MeldableQueue[T])
+}
@@ -0,0 +1,457 @@
+package priorityqueue_test
+
+import (
+	TDAPriorityQueue "adts/priorityqueue"
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_EMPTY_MSG  = "The priority queue is empty"
+	_PANIC_KEY_MSG    = "The new key is greater than the current key"
+	_PANIC_HANDLE_MSG = "The handle does not belong to the priority queue"
+	_PANIC_MELD_MSG   = "The priority queues cannot be melded"
+	VOLUME_SIZE       = 10000
+)
+
+var GRAPH_SIZES = []int{1000, 10000, 100000}
+
+var addressableConstructors = map[string]func(func(int, int) int) TDAPriorityQueue.AddressableQueue[int]{
+	"BinaryHeap": TDAPriorityQueue.NewBinaryHeap[int],
+	"PairingHeap": func(cmp func(int, int) int) TDAPriorityQueue.AddressableQueue[int] {
+		return TDAPriorityQueue.NewPairingHeap(cmp)
+	},
+	"FibonacciHeap": func(cmp func(int, int) int) TDAPriorityQueue.AddressableQueue[int] {
+		return TDAPriorityQueue.NewFibonacciHeap(cmp)
+	},
+}
+
+var meldableConstructors = map[string]func(func(int, int) int) TDAPriorityQueue.MeldableQueue[int]{
+	"PairingHeap":   TDAPriorityQueue.NewPairingHeap[int],
+	"FibonacciHeap": TDAPriorityQueue.NewFibonacciHeap[int],
+}
+
+func intCmp(a, b int) int {
+	return a - b
+}
+
+func TestEmptyPriorityQueue(t *testing.T) {
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			queue := create(intCmp)
+			require.True(t, queue.IsEmpty())
+			require.EqualValues(t, 0, queue.Count())
+			require.PanicsWithValue(t, _PANIC_EMPTY_MSG, func() { queue.Peek() })
+			require.PanicsWithValue(t, _PANIC_EMPTY_MSG, func() { queue.Dequeue() })
+		})
+	}
+}
+
+func TestDequeueInPriorityOrder(t *testing.T) {
+	elements := []int{5, 3, 8, 1, 9, 2, 7, 3, 0, 6}
+	expected := slices.Clone(elements)
+	slices.Sort(expected)
+
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			queue := create(intCmp)
+			for _, elem := range elements {
+				queue.Enqueue(elem)
+			}
+			require.EqualValues(t, len(elements), queue.Count())
+
+			for _, elem := range expected {
+				require.Equal(t, elem, queue.Peek())
+				require.Equal(t, elem, queue.Dequeue())
+			}
+			require.True(t, queue.IsEmpty())
+		})
+	}
+}
+
+func TestMaxHeapWithReversedCmp(t *testing.T) {
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			queue := create(func(a, b int) int { return b - a })
+			for _, elem := range []int{4, 10, 1, 7} {
+				queue.Enqueue(elem)
+			}
+			require.Equal(t, 10, queue.Dequeue())
+			require.Equal(t, 7, queue.Dequeue())
+			require.Equal(t, 4, queue.Dequeue())
+			require.Equal(t, 1, queue.Dequeue())
+		})
+	}
+}
+
+func TestDecreaseKey(t *testing.T) {
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			queue := create(intCmp)
+			handles := make([]TDAPriorityQueue.Handle[int], 10)
+			for i := range handles {
+				handles[i] = queue.Insert(10 * (i + 1))
+			}
+			require.Equal(t, 10, queue.Dequeue())
+
+			queue.DecreaseKey(handles[9], 5)
+			require.Equal(t, 5, handles[9].Value())
+			require.Equal(t, 5, queue.Peek())
+
+			queue.DecreaseKey(handles[4], 15)
+			require.PanicsWithValue(t, _PANIC_KEY_MSG, func() { queue.DecreaseKey(handles[4], 100) })
+
+			require.Equal(t, 5, queue.Dequeue())
+			require.Equal(t, 15, queue.Dequeue())
+			require.Equal(t, 20, queue.Dequeue())
+			require.PanicsWithValue(t, _PANIC_HANDLE_MSG, func() { queue.DecreaseKey(handles[0], 0) })
+		})
+	}
+}
+
+func TestRandomOperationsAgainstSortedSlice(t *testing.T) {
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(42))
+			queue := create(intCmp)
+			handles := map[int]TDAPriorityQueue.Handle[int]{}
+			values := map[int]int{}
+			nextID := 0
+
+			for i := 0; i < VOLUME_SIZE; i++ {
+				switch op := rng.Intn(4); {
+				case op <= 1 || len(values) == 0:
+					// The id in the lowest digits keeps every value unique, so the dequeued one can be identified
+					value := rng.Intn(VOLUME_SIZE)*VOLUME_SIZE + nextID
+					handles[nextID] = queue.Insert(value)
+					values[nextID] = value
+					nextID++
+				case op == 2:
+					for id, value := range values {
+						newValue := value - rng.Intn(100)*VOLUME_SIZE
+						queue.DecreaseKey(handles[id], newValue)
+						values[id] = newValue
+						break
+					}
+				default:
+					expected := math.MaxInt
+					for _, value := range values {
+						expected = min(expected, value)
+					}
+					top := queue.Dequeue()
+					require.Equal(t, expected, top)
+					for id, value := range values {
+						if value == top {
+							delete(values, id)
+							delete(handles, id)
+							break
+						}
+					}
+				}
+				require.EqualValues(t, len(values), queue.Count())
+			}
+		})
+	}
+}
+
+func TestMeld(t *testing.T) {
+	for name, create := range meldableConstructors {
+		t.Run(name, func(t *testing.T) {
+			first := create(intCmp)
+			second := create(intCmp)
+			for i := 0; i < 50; i++ {
+				first.Enqueue(2 * i)
+				second.Enqueue(2*i + 1)
+			}
+			handle := second.Insert(1000)
+
+			first.Meld(second)
+			require.True(t, second.IsEmpty())
+			require.EqualValues(t, 101, first.Count())
+
+			first.DecreaseKey(handle, -1)
+			require.Equal(t, -1, first.Dequeue())
+			for i := 0; i < 100; i++ {
+				require.Equal(t, i, first.Dequeue())
+			}
+			require.True(t, first.IsEmpty())
+		})
+	}
+}
+
+func TestMeldWithEmptyQueues(t *testing.T) {
+	for name, create := range meldableConstructors {
+		t.Run(name, func(t *testing.T) {
+			first := create(intCmp)
+			second := create(intCmp)
+
+			first.Meld(second)
+			require.True(t, first.IsEmpty())
+
+			second.Enqueue(3)
+			first.Meld(second)
+			require.Equal(t, 3, first.Peek())
+
+			first.Meld(first)
+			require.EqualValues(t, 1, first.Count())
+		})
+	}
+}
+
+func TestMeldDifferentImplementations(t *testing.T) {
+	pairing := TDAPriorityQueue.NewPairingHeap[int](intCmp)
+	fibonacci := TDAPriorityQueue.NewFibonacciHeap[int](intCmp)
+	require.PanicsWithValue(t, _PANIC_MELD_MSG, func() { pairing.Meld(fibonacci) })
+	require.PanicsWithValue(t, _PANIC_MELD_MSG, func() { fibonacci.Meld(pairing) })
+}
+
+func TestVolume(t *testing.T) {
+	for name, create := range addressableConstructors {
+		t.Run(name, func(t *testing.T) {
+			queue := create(intCmp)
+			for i := VOLUME_SIZE - 1; i >= 0; i-- {
+				queue.Enqueue(i)
+				require.Equal(t, i, queue.Peek())
+			}
+			for i := 0; i < VOLUME_SIZE; i++ {
+				require.Equal(t, i, queue.Dequeue())
+			}
+			require.True(t, queue.IsEmpty())
+		})
+	}
+}
+
+func TestWithStructs(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	queue := TDAPriorityQueue.NewPairingHeap(func(a, b task) int {
+		return cmp.Compare(a.priority, b.priority)
+	})
+	queue.Enqueue(task{"write", 3})
+	handle := queue.Insert(task{"review", 5})
+	queue.Enqueue(task{"deploy", 4})
+	queue.DecreaseKey(handle, task{"review", 1})
+
+	require.Equal(t, "review", queue.Dequeue().name)
+	require.Equal(t, "write", queue.Dequeue().name)
+	require.Equal(t, "deploy", queue.Dequeue().name)
+}
+
+// ----------------------------- BENCHMARKS -----------------------------
+
+type edge struct {
+	to     int
+	weight int
+}
+
+type distance struct {
+	vertex int
+	dist   int
+}
+
+func randomGraph(n int) [][]edge {
+	rng := rand.New(rand.NewSource(int64(n)))
+	graph := make([][]edge, n)
+	for v := 0; v < n; v++ {
+		graph[v] = append(graph[v], edge{(v + 1) % n, 1 + rng.Intn(100)})
+		for i := 0; i < 4; i++ {
+			graph[v] = append(graph[v], edge{rng.Intn(n), 1 + rng.Intn(100)})
+		}
+	}
+	return graph
+}
+
+// dijkstra runs a decrease-key based Dijkstra over the graph, which is the workload these heaps are meant for
+func dijkstra(graph [][]edge, queue TDAPriorityQueue.AddressableQueue[distance]) []int {
+	dist := make([]int, len(graph))
+	handles := make([]TDAPriorityQueue.Handle[distance], len(graph))
+	visited := make([]bool, len(graph))
+	for v := range dist {
+		dist[v] = -1
+	}
+
+	dist[0] = 0
+	handles[0] = queue.Insert(distance{0, 0})
+	for !queue.IsEmpty() {
+		current := queue.Dequeue()
+		visited[current.vertex] = true
+		for _, e := range graph[current.vertex] {
+			newDist := current.dist + e.weight
+			switch {
+			case visited[e.to]:
+			case dist[e.to] == -1:
+				dist[e.to] = newDist
+				handles[e.to] = queue.Insert(distance{e.to, newDist})
+			case newDist < dist[e.to]:
+				dist[e.to] = newDist
+				queue.DecreaseKey(handles[e.to], distance{e.to, newDist})
+			}
+		}
+	}
+	return dist
+}
+
+func distanceCmp(a, b distance) int {
+	return cmp.Compare(a.dist, b.dist)
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	implementations := map[string]func() TDAPriorityQueue.AddressableQueue[distance]{
+		"BinaryHeap": func() TDAPriorityQueue.AddressableQueue[distance] {
+			return TDAPriorityQueue.NewBinaryHeap(distanceCmp)
+		},
+		"PairingHeap": func() TDAPriorityQueue.AddressableQueue[distance] {
+			return TDAPriorityQueue.NewPairingHeap(distanceCmp)
+		},
+		"FibonacciHeap": func() TDAPriorityQueue.AddressableQueue[distance] {
+			return TDAPriorityQueue.NewFibonacciHeap(distanceCmp)
+		},
+	}
+
+	for _, n := range GRAPH_SIZES {
+		graph := randomGraph(n)
+		expected := dijkstra(graph, implementations["BinaryHeap"]())
+		for _, name := range []string{"BinaryHeap", "PairingHeap", "FibonacciHeap"} {
+			b.Run(fmt.Sprintf("%s %d vertices", name, n), func(b *testing.B) {
+				require.Equal(b, expected, dijkstra(graph, implementations[name]()))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					dijkstra(graph, implementations[name]())
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkMeld(b *testing.B) {
+	const workers, perWorker = 64, 1000
+	for _, name := range []string{"PairingHeap", "FibonacciHeap"} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				total := meldableConstructors[name](intCmp)
+				for w := 0; w < workers; w++ {
+					queue := meldableConstructors[name](intCmp)
+					for j := 0; j < perWorker; j++ {
+						queue.Enqueue(j*workers + w)
+					}
+					total.Meld(queue)
+				}
+				for j := 0; j < workers*perWorker; j++ {
+					if total.Dequeue() != j {
+						b.Fatal("Melded queue is out of order")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityQueueJSON(t *testing.T) {
+	for name, create := range addressableConstructors {
+		queue := create(intCmp)
+		data, err := json.Marshal(queue)
+		require.NoError(t, err, name)
+		require.Equal(t, `[]`, string(data), name)
+
+		rng := rand.New(rand.NewSource(47))
+		for i := 0; i < 200; i++ {
+			queue.Enqueue(rng.Intn(50))
+		}
+		for i := 0; i < 50; i++ {
+			queue.Dequeue()
+		}
+		data, err = json.Marshal(queue)
+		require.NoError(t, err, name)
+		var elements []int
+		require.NoError(t, json.Unmarshal(data, &elements), name)
+		require.Len(t, elements, 150, name)
+		require.True(t, slices.IsSorted(elements), "%s: elements are written in the order they leave", name)
+
+		copied := create(intCmp)
+		copied.Enqueue(-1)
+		require.NoError(t, json.Unmarshal(data, copied), name)
+		require.Equal(t, 150, copied.Count(), name)
+		for _, expected := range elements {
+			require.Equal(t, expected, copied.Dequeue(), name)
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(`null`), queue), name)
+		require.Error(t, json.Unmarshal([]byte(`[1,"two"]`), queue), name)
+		require.Equal(t, 150, queue.Count(), name)
+	}
+}
+
+func TestPriorityQueueJSONAfterDecreaseKey(t *testing.T) {
+	for name, create := range meldableConstructors {
+		queue := create(intCmp)
+		var handles []TDAPriorityQueue.Handle[int]
+		for i := 10; i < 20; i++ {
+			handles = append(handles, queue.Insert(i))
+		}
+		queue.Dequeue()
+		queue.DecreaseKey(handles[7], 1)
+		queue.DecreaseKey(handles[4], 2)
+
+		data, err := json.Marshal(queue)
+		require.NoError(t, err, name)
+		require.Equal(t, `[1,2,11,12,13,15,16,18,19]`, string(data), name)
+	}
+}
+
+func TestPriorityQueueBinary(t *testing.T) {
+	for name, create := range addressableConstructors {
+		queue := create(intCmp)
+		rng := rand.New(rand.NewSource(48))
+		for i := 0; i < 3000; i++ {
+			queue.Enqueue(rng.Intn(1000))
+		}
+		for i := 0; i < 100; i++ {
+			queue.Dequeue()
+		}
+		var buffer bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buffer).Encode(queue), name)
+		data := buffer.Bytes()
+
+		for other, createOther := range addressableConstructors {
+			copied := createOther(intCmp)
+			copied.Enqueue(-1)
+			require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied), "%s into %s", name, other)
+			require.Equal(t, queue.Count(), copied.Count(), "%s into %s", name, other)
+			previous := math.MinInt
+			for !copied.IsEmpty() {
+				element := copied.Dequeue()
+				require.LessOrEqual(t, previous, element, "%s into %s", name, other)
+				previous = element
+			}
+		}
+
+		require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(queue), name)
+		require.Equal(t, 2900, queue.Count(), name)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for name, create := range addressableConstructors {
+		queue := create(intCmp)
+		require.Equal(t, "PriorityQueue[]", fmt.Sprint(queue), name)
+		for _, element := range []int{5, 1, 4, 2, 3} {
+			queue.Enqueue(element)
+		}
+		queue.Dequeue()
+		require.Equal(t, "PriorityQueue[top: 2, 3, 4, 5]", fmt.Sprint(queue), name)
+		require.Equal(t, "priorityqueue."+name+"[int]{2, 3, 4, 5}", fmt.Sprintf("%#v", queue), name)
+		require.Equal(t, 4, queue.Count(), "Formatting does not change the heap")
+		require.Equal(t, 2, queue.Dequeue(), name)
+	}
+}
@@ -0,0 +1,62 @@
+package queue
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "queue"
+
+// ------------ BINARY ENCODING ------------ //
+
+// WriteTo writes a binary snapshot of the queue, from its front to its rear, encoding the elements with
+// encoding/gob.
+func (q *linkedQueue[T]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for current := q.front; current != nil; current = current.next {
+		count++
+	}
+	err = snapshot.Write(writer, count, func(visit func(T) bool) {
+		for current := q.front; current != nil; current = current.next {
+			if !visit(current.data) {
+				return
+			}
+		}
+	})
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the elements of the queue with those of a snapshot written by WriteTo, reading nothing past
+// its end. If the snapshot cannot be read, it returns an error without changing the queue.
+func (q *linkedQueue[T]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := &linkedQueue[T]{}
+	if err := snapshot.Read(reader, read.Enqueue); err != nil {
+		return reader.BytesRead(), err
+	}
+	*q = *read
+	return reader.BytesRead(), nil
+}
+
+func (q *linkedQueue[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(q)
+}
+
+func (q *linkedQueue[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, q)
+}
+
+func (q *linkedQueue[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(q)
+}
+
+func (q *linkedQueue[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, q)
+}
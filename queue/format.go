@@ -0,0 +1,32 @@
+package queue
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ------------ FORMATTING ------------ //
+
+// String formats the queue from its front to its rear, like Queue[front: 1, 2, 3], or Queue[] if it is empty.
+func (q *linkedQueue[T]) String() string {
+	if q.front == nil {
+		return "Queue[]"
+	}
+	return "Queue[front: " + q.join("%v") + "]"
+}
+
+// GoString formats the queue as Go syntax, from its front to its rear, like queue.LinkedQueue[int]{1, 2, 3}.
+func (q *linkedQueue[T]) GoString() string {
+	return fmt.Sprintf("queue.LinkedQueue[%v]{%s}", reflect.TypeFor[T](), q.join("%#v"))
+}
+
+// ------------ HELPER FUNCTIONS ------------ //
+
+func (q *linkedQueue[T]) join(verb string) string {
+	var texts []string
+	for current := q.front; current != nil; current = current.next {
+		texts = append(texts, fmt.Sprintf(verb, current.data))
+	}
+	return strings.Join(texts, ", ")
+}
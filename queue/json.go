@@ -0,0 +1,36 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ------------ JSON ENCODING ------------ //
+
+// MarshalJSON encodes the queue as a JSON array, from its front to its rear.
+func (q *linkedQueue[T]) MarshalJSON() ([]byte, error) {
+	elements := []T{}
+	for current := q.front; current != nil; current = current.next {
+		elements = append(elements, current.data)
+	}
+	return json.Marshal(elements)
+}
+
+// UnmarshalJSON replaces the elements of the queue with those of a JSON array, whose first element ends up in
+// front. A JSON null leaves the queue as it is, and an array that cannot be decoded returns an error without
+// changing it.
+func (q *linkedQueue[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	q.front, q.rear = nil, nil
+	for _, element := range elements {
+		q.Enqueue(element)
+	}
+	return nil
+}
@@ -3,6 +3,10 @@ package queue_test
 import (
 	QueuePkg "adts/queue"
 	StackPkg "adts/stack"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -164,3 +168,65 @@ func testQueue[T comparable](t *testing.T, elements []T) {
 
 	require.True(t, queue.IsEmpty())
 }
+
+func TestQueueJSON(t *testing.T) {
+	queue := QueuePkg.NewLinkedQueue[int]()
+	data, err := json.Marshal(queue)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	for i := 1; i <= 3; i++ {
+		queue.Enqueue(i)
+	}
+	queue.Dequeue()
+	data, err = json.Marshal(queue)
+	require.NoError(t, err)
+	require.Equal(t, `[2,3]`, string(data))
+
+	copied := QueuePkg.NewLinkedQueue[int]()
+	copied.Enqueue(9)
+	require.NoError(t, json.Unmarshal(data, copied))
+	copied.Enqueue(4)
+	for i := 2; i <= 4; i++ {
+		require.Equal(t, i, copied.Dequeue())
+	}
+	require.True(t, copied.IsEmpty())
+
+	queue.Enqueue(4)
+	require.NoError(t, json.Unmarshal([]byte(`null`), queue))
+	require.Error(t, json.Unmarshal([]byte(`[2,"three"]`), queue))
+	require.Equal(t, 2, queue.Front())
+}
+
+func TestQueueBinary(t *testing.T) {
+	queue := QueuePkg.NewLinkedQueue[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(queue))
+	copied := QueuePkg.NewLinkedQueue[string]()
+	copied.Enqueue("z")
+	require.NoError(t, gob.NewDecoder(&buffer).Decode(copied))
+	copied.Enqueue("c")
+	for _, expected := range []string{"a", "b", "c"} {
+		require.Equal(t, expected, copied.Dequeue())
+	}
+	require.True(t, copied.IsEmpty())
+
+	stack := StackPkg.NewDynamicStack[string]()
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(stack))
+	require.Error(t, gob.NewDecoder(&buffer).Decode(queue), "A stack is not read as a queue")
+	require.Equal(t, "a", queue.Front())
+}
+
+func TestQueueFormat(t *testing.T) {
+	queue := QueuePkg.NewLinkedQueue[string]()
+	require.Equal(t, "Queue[]", fmt.Sprint(queue))
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	queue.Dequeue()
+	require.Equal(t, "Queue[front: b, c]", fmt.Sprint(queue))
+	require.Equal(t, `queue.LinkedQueue[string]{"b", "c"}`, fmt.Sprintf("%#v", queue))
+}
@@ -0,0 +1,127 @@
+package rangequery
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "rangequery"
+
+// The trees write binary snapshots of the values of their array, encoded with encoding/gob, so that any of them can
+// read the snapshot of another one with values of the same type. Reading a snapshot builds the tree again over its
+// values, which may be of another length, with the same functions, reading nothing past the end of the snapshot. If
+// it cannot be read, it returns an error without changing the tree.
+
+// ------------------------ BINARY PRIMITIVES ------------------------
+
+func (tree *fenwickTree[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeValues(w, tree.Length(), tree.Get)
+}
+
+func (tree *fenwickTree[T]) ReadFrom(r io.Reader) (int64, error) {
+	return readValues(r, func(values []T) {
+		*tree = *CreateFenwickTree(values).(*fenwickTree[T])
+	})
+}
+
+func (tree *segmentTree[T]) WriteTo(w io.Writer) (int64, error) {
+	return writeValues(w, tree.length, tree.Get)
+}
+
+func (tree *segmentTree[T]) ReadFrom(r io.Reader) (int64, error) {
+	return readValues(r, func(values []T) {
+		*tree = *CreateSegmentTree(values, tree.combine, tree.identity).(*segmentTree[T])
+	})
+}
+
+// WriteTo applies first the updates that were still pending on the values.
+func (tree *lazySegmentTree[T, U]) WriteTo(w io.Writer) (int64, error) {
+	return writeValues(w, tree.length, tree.Get)
+}
+
+func (tree *lazySegmentTree[T, U]) ReadFrom(r io.Reader) (int64, error) {
+	return readValues(r, func(values []T) {
+		*tree = *CreateLazySegmentTree(values, tree.combine, tree.identity, tree.apply, tree.compose).(*lazySegmentTree[T, U])
+	})
+}
+
+func (tree *fenwickTree[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *fenwickTree[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *fenwickTree[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *fenwickTree[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *segmentTree[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *segmentTree[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *segmentTree[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *segmentTree[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *lazySegmentTree[T, U]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *lazySegmentTree[T, U]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *lazySegmentTree[T, U]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *lazySegmentTree[T, U]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func writeValues[T any](w io.Writer, length int, get func(int) T) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, length, func(visit func(T) bool) {
+		for i := 0; i < length; i++ {
+			if !visit(get(i)) {
+				return
+			}
+		}
+	})
+	return writer.BytesWritten(), err
+}
+
+// readValues reads the values of the snapshot and, if it succeeds, passes them to rebuild
+func readValues[T any](r io.Reader, rebuild func([]T)) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	values := []T{}
+	err = snapshot.Read(reader, func(value T) {
+		values = append(values, value)
+	})
+	if err == nil {
+		rebuild(values)
+	}
+	return reader.BytesRead(), err
+}
@@ -0,0 +1,56 @@
+package rangequery
+
+// fenwickTree stores in position i, counting from 1, the sum of the values in the positions from i-lowbit(i)+1 to
+// i, lowbit(i) being the lowest set bit of i.
+type fenwickTree[T Number] struct {
+	sums []T
+}
+
+// CreateFenwickTree creates a FenwickTree over a copy of the values, in linear time.
+func CreateFenwickTree[T Number](values []T) FenwickTree[T] {
+	tree := &fenwickTree[T]{sums: make([]T, len(values)+1)}
+	copy(tree.sums[1:], values)
+	for i := 1; i < len(tree.sums); i++ {
+		if parent := i + i&-i; parent < len(tree.sums) {
+			tree.sums[parent] += tree.sums[i]
+		}
+	}
+	return tree
+}
+
+// ------------------------ TREE PRIMITIVES ------------------------
+
+func (tree *fenwickTree[T]) Length() int {
+	return len(tree.sums) - 1
+}
+
+func (tree *fenwickTree[T]) Get(i int) T {
+	return tree.RangeSum(i, i)
+}
+
+func (tree *fenwickTree[T]) Add(i int, delta T) {
+	checkIndex(i, tree.Length())
+	for pos := i + 1; pos < len(tree.sums); pos += pos & -pos {
+		tree.sums[pos] += delta
+	}
+}
+
+func (tree *fenwickTree[T]) Set(i int, value T) {
+	tree.Add(i, value-tree.Get(i))
+}
+
+func (tree *fenwickTree[T]) PrefixSum(count int) T {
+	if count < 0 || count > tree.Length() {
+		panic(_PANIC_MESSAGE_INDEX)
+	}
+	var sum T
+	for pos := count; pos > 0; pos -= pos & -pos {
+		sum += tree.sums[pos]
+	}
+	return sum
+}
+
+func (tree *fenwickTree[T]) RangeSum(from int, to int) T {
+	checkRange(from, to, tree.Length())
+	return tree.PrefixSum(to+1) - tree.PrefixSum(from)
+}
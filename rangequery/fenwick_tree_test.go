@@ -0,0 +1,65 @@
+package rangequery_test
+
+import (
+	TDARangeQuery "adts/rangequery"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFenwickTreeInvalidIndexes(t *testing.T) {
+	tree := TDARangeQuery.CreateFenwickTree([]int{1, 2, 3})
+	require.EqualValues(t, 3, tree.Length())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.Get(3) })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.Add(-1, 1) })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.PrefixSum(4) })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_RANGE, func() { tree.RangeSum(2, 1) })
+
+	empty := TDARangeQuery.CreateFenwickTree([]float64{})
+	require.EqualValues(t, 0, empty.PrefixSum(0))
+}
+
+func TestFenwickTreePrefixSums(t *testing.T) {
+	tree := TDARangeQuery.CreateFenwickTree([]float64{1.5, 2, 3, 4.5})
+	require.EqualValues(t, 0, tree.PrefixSum(0))
+	require.EqualValues(t, 3.5, tree.PrefixSum(2))
+	require.EqualValues(t, 11, tree.PrefixSum(4))
+	require.EqualValues(t, 7.5, tree.RangeSum(2, 3))
+
+	tree.Add(1, 10)
+	require.EqualValues(t, 12, tree.Get(1))
+	tree.Set(3, 0)
+	require.EqualValues(t, 16.5, tree.PrefixSum(4))
+}
+
+func TestFenwickTreeAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(41))
+	for _, n := range []int{1, 2, 10, 128, 333} {
+		values := randomValues(rng, n)
+		tree := TDARangeQuery.CreateFenwickTree(values)
+
+		for i := 0; i < 3000; i++ {
+			pos := rng.Intn(n)
+			switch rng.Intn(4) {
+			case 0:
+				delta := rng.Intn(201) - 100
+				values[pos] += delta
+				tree.Add(pos, delta)
+			case 1:
+				values[pos] = rng.Intn(2001) - 1000
+				tree.Set(pos, values[pos])
+			case 2:
+				count := rng.Intn(n + 1)
+				require.EqualValues(t, bruteForce(values, 0, count-1, sum, 0), tree.PrefixSum(count))
+			default:
+				to := pos + rng.Intn(n-pos)
+				require.EqualValues(t, bruteForce(values, pos, to, sum, 0), tree.RangeSum(pos, to))
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			require.EqualValues(t, values[i], tree.Get(i))
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package rangequery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ------------------------ FORMAT PRIMITIVES ------------------------
+
+// String formats the values of the array, like FenwickTree[1 2 3].
+func (tree *fenwickTree[T]) String() string {
+	return "FenwickTree[" + joinValues(tree.Length(), tree.Get, "%v", " ") + "]"
+}
+
+func (tree *fenwickTree[T]) GoString() string {
+	return fmt.Sprintf("rangequery.FenwickTree[%v]{%s}", reflect.TypeFor[T](), joinValues(tree.Length(), tree.Get, "%#v", ", "))
+}
+
+// String formats the values of the array, like SegmentTree[1 2 3].
+func (tree *segmentTree[T]) String() string {
+	return "SegmentTree[" + joinValues(tree.length, tree.Get, "%v", " ") + "]"
+}
+
+func (tree *segmentTree[T]) GoString() string {
+	return fmt.Sprintf("rangequery.SegmentTree[%v]{%s}", reflect.TypeFor[T](), joinValues(tree.length, tree.Get, "%#v", ", "))
+}
+
+// String formats the values of the array, like LazySegmentTree[1 2 3], applying first the updates that were
+// still pending on them.
+func (tree *lazySegmentTree[T, U]) String() string {
+	return "LazySegmentTree[" + joinValues(tree.length, tree.Get, "%v", " ") + "]"
+}
+
+func (tree *lazySegmentTree[T, U]) GoString() string {
+	return fmt.Sprintf("rangequery.LazySegmentTree[%v, %v]{%s}", reflect.TypeFor[T](), reflect.TypeFor[U](),
+		joinValues(tree.length, tree.Get, "%#v", ", "))
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func joinValues[T any](length int, get func(int) T, verb string, separator string) string {
+	texts := make([]string, length)
+	for i := range texts {
+		texts[i] = fmt.Sprintf(verb, get(i))
+	}
+	return strings.Join(texts, separator)
+}
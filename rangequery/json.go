@@ -0,0 +1,67 @@
+package rangequery
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// The trees are encoded as a JSON array of the values of their array, so that the encoding does not depend on how
+// they are stored. Decoding an array builds the tree again over its values, which may be of another length, with
+// the same functions. A JSON null leaves the tree as it is, and an array that cannot be decoded returns an error
+// without changing it.
+
+// ------------------------ JSON PRIMITIVES ------------------------
+
+func (tree *fenwickTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(arrayValues(tree.Length(), tree.Get))
+}
+
+func (tree *fenwickTree[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalValues(data, func(values []T) {
+		*tree = *CreateFenwickTree(values).(*fenwickTree[T])
+	})
+}
+
+func (tree *segmentTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(arrayValues(tree.length, tree.Get))
+}
+
+func (tree *segmentTree[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalValues(data, func(values []T) {
+		*tree = *CreateSegmentTree(values, tree.combine, tree.identity).(*segmentTree[T])
+	})
+}
+
+// MarshalJSON applies first the updates that were still pending on the values.
+func (tree *lazySegmentTree[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(arrayValues(tree.length, tree.Get))
+}
+
+func (tree *lazySegmentTree[T, U]) UnmarshalJSON(data []byte) error {
+	return unmarshalValues(data, func(values []T) {
+		*tree = *CreateLazySegmentTree(values, tree.combine, tree.identity, tree.apply, tree.compose).(*lazySegmentTree[T, U])
+	})
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func arrayValues[T any](length int, get func(int) T) []T {
+	values := make([]T, length)
+	for i := range values {
+		values[i] = get(i)
+	}
+	return values
+}
+
+// unmarshalValues decodes a JSON array of values and, if it succeeds, passes them to rebuild
+func unmarshalValues[T any](data []byte, rebuild func([]T)) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	values := []T{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	rebuild(values)
+	return nil
+}
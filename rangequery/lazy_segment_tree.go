@@ -0,0 +1,146 @@
+package rangequery
+
+// lazySegmentTree is stored top down, position 1 covering the whole array and every position i covering the halves
+// of its range in positions 2*i and 2*i+1. pending[i] holds an update already applied to position i but not yet to
+// its children.
+type lazySegmentTree[T, U any] struct {
+	nodes      []T
+	pending    []U
+	hasPending []bool
+	length     int
+	combine    func(T, T) T
+	identity   T
+	apply      func(T, U, int) T
+	compose    func(U, U) U
+}
+
+// CreateLazySegmentTree creates a LazySegmentTree over a copy of the values, where combine and identity work as in
+// CreateSegmentTree. apply(aggregate, update, length) must return the aggregate of a range of that length after
+// the update, as aggregate+update*length does for adding to a sum, and compose(newer, older) must return the
+// update that has the same effect as applying older and then newer.
+func CreateLazySegmentTree[T, U any](values []T, combine func(T, T) T, identity T,
+	apply func(aggregate T, update U, length int) T, compose func(newer U, older U) U) LazySegmentTree[T, U] {
+
+	length := len(values)
+	tree := &lazySegmentTree[T, U]{
+		nodes:      make([]T, 4*max(length, 1)),
+		pending:    make([]U, 4*max(length, 1)),
+		hasPending: make([]bool, 4*max(length, 1)),
+		length:     length,
+		combine:    combine,
+		identity:   identity,
+		apply:      apply,
+		compose:    compose,
+	}
+	if length > 0 {
+		tree.build(1, 0, length-1, values)
+	}
+	return tree
+}
+
+// ------------------------ TREE PRIMITIVES ------------------------
+
+func (tree *lazySegmentTree[T, U]) Length() int {
+	return tree.length
+}
+
+func (tree *lazySegmentTree[T, U]) Get(i int) T {
+	return tree.Query(i, i)
+}
+
+func (tree *lazySegmentTree[T, U]) Set(i int, value T) {
+	checkIndex(i, tree.length)
+	tree.set(1, 0, tree.length-1, i, value)
+}
+
+func (tree *lazySegmentTree[T, U]) Query(from int, to int) T {
+	checkRange(from, to, tree.length)
+	return tree.query(1, 0, tree.length-1, from, to)
+}
+
+func (tree *lazySegmentTree[T, U]) Update(from int, to int, update U) {
+	checkRange(from, to, tree.length)
+	tree.update(1, 0, tree.length-1, from, to, update)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Every function works on the position node, which covers the values from lo to hi
+
+func (tree *lazySegmentTree[T, U]) build(node int, lo int, hi int, values []T) {
+	if lo == hi {
+		tree.nodes[node] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	tree.build(2*node, lo, mid, values)
+	tree.build(2*node+1, mid+1, hi, values)
+	tree.nodes[node] = tree.combine(tree.nodes[2*node], tree.nodes[2*node+1])
+}
+
+func (tree *lazySegmentTree[T, U]) set(node int, lo int, hi int, i int, value T) {
+	if lo == hi {
+		tree.nodes[node] = value
+		return
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	if i <= mid {
+		tree.set(2*node, lo, mid, i, value)
+	} else {
+		tree.set(2*node+1, mid+1, hi, i, value)
+	}
+	tree.nodes[node] = tree.combine(tree.nodes[2*node], tree.nodes[2*node+1])
+}
+
+func (tree *lazySegmentTree[T, U]) query(node int, lo int, hi int, from int, to int) T {
+	if to < lo || hi < from {
+		return tree.identity
+	}
+	if from <= lo && hi <= to {
+		return tree.nodes[node]
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	return tree.combine(tree.query(2*node, lo, mid, from, to), tree.query(2*node+1, mid+1, hi, from, to))
+}
+
+func (tree *lazySegmentTree[T, U]) update(node int, lo int, hi int, from int, to int, update U) {
+	if to < lo || hi < from {
+		return
+	}
+	if from <= lo && hi <= to {
+		tree.applyTo(node, lo, hi, update)
+		return
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	tree.update(2*node, lo, mid, from, to, update)
+	tree.update(2*node+1, mid+1, hi, from, to, update)
+	tree.nodes[node] = tree.combine(tree.nodes[2*node], tree.nodes[2*node+1])
+}
+
+// applyTo applies the update to the aggregate of the node, leaving it pending for its children
+func (tree *lazySegmentTree[T, U]) applyTo(node int, lo int, hi int, update U) {
+	tree.nodes[node] = tree.apply(tree.nodes[node], update, hi-lo+1)
+	if lo == hi {
+		return
+	}
+	if tree.hasPending[node] {
+		update = tree.compose(update, tree.pending[node])
+	}
+	tree.pending[node], tree.hasPending[node] = update, true
+}
+
+// push hands the pending update of the node down to its children
+func (tree *lazySegmentTree[T, U]) push(node int, lo int, hi int) {
+	if !tree.hasPending[node] {
+		return
+	}
+	mid := (lo + hi) / 2
+	tree.applyTo(2*node, lo, mid, tree.pending[node])
+	tree.applyTo(2*node+1, mid+1, hi, tree.pending[node])
+
+	var none U
+	tree.pending[node], tree.hasPending[node] = none, false
+}
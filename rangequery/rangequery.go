@@ -0,0 +1,67 @@
+package rangequery
+
+// SegmentTree aggregates the values of a fixed length array, combining them with an associative function. Ranges
+// are given by the positions of their first and last values, both included.
+type SegmentTree[T any] interface {
+
+	// Length returns the number of values in the array.
+	Length() int
+
+	// Get returns the value in position i. If i is not a position of the array, it must panic with the message
+	// 'The index is out of range'.
+	Get(i int) T
+
+	// Set replaces the value in position i. If i is not a position of the array, it must panic with the message
+	// 'The index is out of range'.
+	Set(i int, value T)
+
+	// Query returns the combination, in order, of the values from position from to position to. If from is
+	// greater than to, it must panic with the message 'The range is not valid', and if any of them is not a
+	// position of the array, with 'The index is out of range'.
+	Query(from int, to int) T
+}
+
+// LazySegmentTree is a SegmentTree that can also apply an update to every value of a range at once, postponing the
+// work on each part of the range until a query or another update needs it.
+type LazySegmentTree[T any, U any] interface {
+	SegmentTree[T]
+
+	// Update applies the update to the values from position from to position to. It panics as Query does with
+	// invalid ranges.
+	Update(from int, to int, update U)
+}
+
+// Number is the type of the values of a FenwickTree, which must be added and subtracted.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// FenwickTree, or binary indexed tree, keeps the prefix sums of a fixed length array of numbers up to date as its
+// values change.
+type FenwickTree[T Number] interface {
+
+	// Length returns the number of values in the array.
+	Length() int
+
+	// Get returns the value in position i. If i is not a position of the array, it must panic with the message
+	// 'The index is out of range'.
+	Get(i int) T
+
+	// Add adds delta to the value in position i. If i is not a position of the array, it must panic with the
+	// message 'The index is out of range'.
+	Add(i int, delta T)
+
+	// Set replaces the value in position i. If i is not a position of the array, it must panic with the message
+	// 'The index is out of range'.
+	Set(i int, value T)
+
+	// PrefixSum returns the sum of the first count values. If count is negative or greater than the length, it
+	// must panic with the message 'The index is out of range'.
+	PrefixSum(count int) T
+
+	// RangeSum returns the sum of the values from position from to position to, both included. It panics as
+	// SegmentTree.Query does with invalid ranges.
+	RangeSum(from int, to int) T
+}
@@ -0,0 +1,82 @@
+package rangequery
+
+const (
+	_PANIC_MESSAGE_INDEX = "The index is out of range"
+	_PANIC_MESSAGE_RANGE = "The range is not valid"
+)
+
+// segmentTree is stored bottom up in a single slice: the values are the leaves in positions length to
+// 2*length-1, and every position i below them combines positions 2*i and 2*i+1.
+type segmentTree[T any] struct {
+	nodes    []T
+	length   int
+	combine  func(T, T) T
+	identity T
+}
+
+// CreateSegmentTree creates a SegmentTree over a copy of the values. combine must be associative, though not
+// necessarily commutative, and identity must leave any value unchanged when combined with it, as 0 does for the
+// sum or +Inf for the minimum.
+func CreateSegmentTree[T any](values []T, combine func(T, T) T, identity T) SegmentTree[T] {
+	length := len(values)
+	tree := &segmentTree[T]{nodes: make([]T, 2*length), length: length, combine: combine, identity: identity}
+	copy(tree.nodes[length:], values)
+	for i := length - 1; i > 0; i-- {
+		tree.nodes[i] = combine(tree.nodes[2*i], tree.nodes[2*i+1])
+	}
+	return tree
+}
+
+// ------------------------ TREE PRIMITIVES ------------------------
+
+func (tree *segmentTree[T]) Length() int {
+	return tree.length
+}
+
+func (tree *segmentTree[T]) Get(i int) T {
+	checkIndex(i, tree.length)
+	return tree.nodes[tree.length+i]
+}
+
+func (tree *segmentTree[T]) Set(i int, value T) {
+	checkIndex(i, tree.length)
+	pos := tree.length + i
+	tree.nodes[pos] = value
+	for pos /= 2; pos > 0; pos /= 2 {
+		tree.nodes[pos] = tree.combine(tree.nodes[2*pos], tree.nodes[2*pos+1])
+	}
+}
+
+func (tree *segmentTree[T]) Query(from int, to int) T {
+	checkRange(from, to, tree.length)
+
+	// Both ends climb towards each other, keeping apart what they gather so that the order is preserved
+	left, right := tree.identity, tree.identity
+	for lo, hi := from+tree.length, to+tree.length+1; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo%2 == 1 {
+			left = tree.combine(left, tree.nodes[lo])
+			lo++
+		}
+		if hi%2 == 1 {
+			hi--
+			right = tree.combine(tree.nodes[hi], right)
+		}
+	}
+	return tree.combine(left, right)
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func checkIndex(i int, length int) {
+	if i < 0 || i >= length {
+		panic(_PANIC_MESSAGE_INDEX)
+	}
+}
+
+func checkRange(from int, to int, length int) {
+	if from > to {
+		panic(_PANIC_MESSAGE_RANGE)
+	}
+	checkIndex(from, length)
+	checkIndex(to, length)
+}
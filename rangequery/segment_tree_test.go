@@ -0,0 +1,280 @@
+package rangequery_test
+
+import (
+	TDARangeQuery "adts/rangequery"
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_MESSAGE_INDEX = "The index is out of range"
+	_PANIC_MESSAGE_RANGE = "The range is not valid"
+)
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func randomValues(rng *rand.Rand, n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = rng.Intn(2001) - 1000
+	}
+	return values
+}
+
+// bruteForce combines values[from..to] one by one, as the trees should
+func bruteForce[T any](values []T, from int, to int, combine func(T, T) T, identity T) T {
+	result := identity
+	for i := from; i <= to; i++ {
+		result = combine(result, values[i])
+	}
+	return result
+}
+
+func TestSegmentTreeInvalidRanges(t *testing.T) {
+	trees := map[string]TDARangeQuery.SegmentTree[int]{
+		"SegmentTree":     TDARangeQuery.CreateSegmentTree([]int{1, 2, 3}, sum, 0),
+		"LazySegmentTree": TDARangeQuery.CreateLazySegmentTree([]int{1, 2, 3}, sum, 0, addToSum, sum),
+	}
+	for name, tree := range trees {
+		t.Run(name, func(t *testing.T) {
+			require.EqualValues(t, 3, tree.Length())
+			require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.Get(3) })
+			require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.Set(-1, 0) })
+			require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { tree.Query(0, 3) })
+			require.PanicsWithValue(t, _PANIC_MESSAGE_RANGE, func() { tree.Query(2, 1) })
+		})
+	}
+
+	empty := TDARangeQuery.CreateLazySegmentTree([]int{}, sum, 0, addToSum, sum)
+	require.EqualValues(t, 0, empty.Length())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_INDEX, func() { empty.Update(0, 0, 1) })
+}
+
+func TestSegmentTreeRangeMinimum(t *testing.T) {
+	temperatures := []float64{21.5, 19.0, 23.1, 18.2, 25.0, 22.4}
+	tree := TDARangeQuery.CreateSegmentTree(temperatures, math.Min, math.Inf(1))
+	require.EqualValues(t, 18.2, tree.Query(0, 5))
+	require.EqualValues(t, 19.0, tree.Query(0, 2))
+	require.EqualValues(t, 25.0, tree.Query(4, 4))
+
+	tree.Set(3, 30)
+	require.EqualValues(t, 22.4, tree.Query(2, 5))
+	require.EqualValues(t, 30, tree.Get(3))
+	require.EqualValues(t, 18.2, temperatures[3], "The tree works on a copy of the values")
+}
+
+func TestSegmentTreeKeepsOrder(t *testing.T) {
+	t.Log("A combine function that is not commutative, as concatenation, sees the values in order")
+	words := []string{"a", "b", "c", "d", "e", "f", "g"}
+	concat := func(a, b string) string { return a + b }
+	for _, tree := range []TDARangeQuery.SegmentTree[string]{
+		TDARangeQuery.CreateSegmentTree(words, concat, ""),
+		TDARangeQuery.CreateLazySegmentTree(words, concat, "", func(s string, _ bool, _ int) string { return s },
+			func(bool, bool) bool { return true }),
+	} {
+		for from := range words {
+			for to := from; to < len(words); to++ {
+				require.EqualValues(t, strings.Join(words[from:to+1], ""), tree.Query(from, to))
+			}
+		}
+	}
+}
+
+func TestSegmentTreeAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+	for _, n := range []int{1, 2, 7, 64, 300} {
+		values := randomValues(rng, n)
+		minimum := func(a, b int) int { return min(a, b) }
+		sums := TDARangeQuery.CreateSegmentTree(values, sum, 0)
+		minimums := TDARangeQuery.CreateSegmentTree(values, minimum, math.MaxInt)
+
+		for i := 0; i < 2000; i++ {
+			if rng.Intn(3) == 0 {
+				pos, value := rng.Intn(n), rng.Intn(2001)-1000
+				values[pos] = value
+				sums.Set(pos, value)
+				minimums.Set(pos, value)
+				continue
+			}
+			from := rng.Intn(n)
+			to := from + rng.Intn(n-from)
+			require.EqualValues(t, bruteForce(values, from, to, sum, 0), sums.Query(from, to))
+			require.EqualValues(t, bruteForce(values, from, to, minimum, math.MaxInt), minimums.Query(from, to))
+		}
+	}
+}
+
+// ------------------------------ LAZY ------------------------------
+
+func addToSum(total int, add int, length int) int {
+	return total + add*length
+}
+
+// assignment replaces every value of a range, and is composed by keeping the newest
+type assignment struct {
+	value int
+}
+
+func TestLazySegmentTreeRangeAddAndSum(t *testing.T) {
+	tree := TDARangeQuery.CreateLazySegmentTree([]int{1, 2, 3, 4, 5}, sum, 0, addToSum, sum)
+	tree.Update(1, 3, 10)
+	require.EqualValues(t, 45, tree.Query(0, 4))
+	require.EqualValues(t, 12, tree.Get(1))
+	tree.Update(0, 1, -1)
+	require.EqualValues(t, 11, tree.Query(0, 1))
+	tree.Set(2, 0)
+	require.EqualValues(t, 30, tree.Query(1, 4))
+}
+
+func TestLazySegmentTreeAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(37))
+	minimum := func(a, b int) int { return min(a, b) }
+	assignToMin := func(_ int, update assignment, _ int) int { return update.value }
+	keepNewest := func(newer assignment, _ assignment) assignment { return newer }
+
+	for _, n := range []int{1, 3, 16, 100, 257} {
+		values := randomValues(rng, n)
+		sums := TDARangeQuery.CreateLazySegmentTree(values, sum, 0, addToSum, sum)
+		minimums := TDARangeQuery.CreateLazySegmentTree(values, minimum, math.MaxInt, assignToMin, keepNewest)
+		added := append([]int{}, values...)
+		assigned := append([]int{}, values...)
+
+		for i := 0; i < 3000; i++ {
+			from := rng.Intn(n)
+			to := from + rng.Intn(n-from)
+			switch rng.Intn(4) {
+			case 0:
+				delta := rng.Intn(201) - 100
+				for j := from; j <= to; j++ {
+					added[j] += delta
+				}
+				sums.Update(from, to, delta)
+			case 1:
+				value := rng.Intn(2001) - 1000
+				for j := from; j <= to; j++ {
+					assigned[j] = value
+				}
+				minimums.Update(from, to, assignment{value})
+			case 2:
+				value := rng.Intn(2001) - 1000
+				added[from], assigned[from] = value, value
+				sums.Set(from, value)
+				minimums.Set(from, value)
+			default:
+				require.EqualValues(t, bruteForce(added, from, to, sum, 0), sums.Query(from, to))
+				require.EqualValues(t, bruteForce(assigned, from, to, minimum, math.MaxInt), minimums.Query(from, to))
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			require.EqualValues(t, added[i], sums.Get(i))
+			require.EqualValues(t, assigned[i], minimums.Get(i))
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	fenwick := TDARangeQuery.CreateFenwickTree([]int{1, 2, 3})
+	fenwick.Add(1, 5)
+	require.Equal(t, "FenwickTree[1 7 3]", fmt.Sprint(fenwick))
+	require.Equal(t, "rangequery.FenwickTree[int]{1, 7, 3}", fmt.Sprintf("%#v", fenwick))
+
+	segment := TDARangeQuery.CreateSegmentTree([]string{"a", "b"}, func(a, b string) string { return a + b }, "")
+	require.Equal(t, "SegmentTree[a b]", fmt.Sprint(segment))
+	require.Equal(t, `rangequery.SegmentTree[string]{"a", "b"}`, fmt.Sprintf("%#v", segment))
+
+	lazy := TDARangeQuery.CreateLazySegmentTree([]int{1, 2, 3, 4}, sum, 0, addToSum, sum)
+	lazy.Update(1, 3, 10)
+	require.Equal(t, "LazySegmentTree[1 12 13 14]", fmt.Sprint(lazy), "Pending updates are applied")
+	require.Equal(t, "rangequery.LazySegmentTree[int, int]{1, 12, 13, 14}", fmt.Sprintf("%#v", lazy))
+	require.EqualValues(t, 40, lazy.Query(0, 3))
+}
+
+func TestJSON(t *testing.T) {
+	fenwick := TDARangeQuery.CreateFenwickTree([]int{1, 2, 3})
+	fenwick.Add(1, 5)
+	data, err := json.Marshal(fenwick)
+	require.NoError(t, err)
+	require.Equal(t, `[1,7,3]`, string(data))
+	copiedFenwick := TDARangeQuery.CreateFenwickTree([]int{9})
+	require.NoError(t, json.Unmarshal([]byte(`[4,5,6,7]`), copiedFenwick))
+	require.EqualValues(t, 4, copiedFenwick.Length())
+	require.EqualValues(t, 18, copiedFenwick.RangeSum(1, 3))
+
+	concat := func(a, b string) string { return a + b }
+	segment := TDARangeQuery.CreateSegmentTree([]string{"a", "b"}, concat, "")
+	data, err = json.Marshal(segment)
+	require.NoError(t, err)
+	require.Equal(t, `["a","b"]`, string(data))
+	require.NoError(t, json.Unmarshal([]byte(`["x","y","z"]`), segment))
+	require.Equal(t, "xyz", segment.Query(0, 2), "The tree keeps its combine function")
+
+	lazy := TDARangeQuery.CreateLazySegmentTree([]int{1, 2, 3, 4}, sum, 0, addToSum, sum)
+	lazy.Update(1, 3, 10)
+	data, err = json.Marshal(lazy)
+	require.NoError(t, err)
+	require.Equal(t, `[1,12,13,14]`, string(data), "Pending updates are applied")
+	copiedLazy := TDARangeQuery.CreateLazySegmentTree([]int{}, sum, 0, addToSum, sum)
+	require.NoError(t, json.Unmarshal(data, copiedLazy))
+	copiedLazy.Update(0, 1, 1)
+	require.EqualValues(t, 42, copiedLazy.Query(0, 3))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), fenwick))
+	require.Error(t, json.Unmarshal([]byte(`[1,"two"]`), fenwick))
+	require.Error(t, json.Unmarshal([]byte(`{}`), segment))
+	require.Error(t, json.Unmarshal([]byte(`[1.5]`), lazy))
+	require.Equal(t, []int{1, 7, 3}, []int{fenwick.Get(0), fenwick.Get(1), fenwick.Get(2)}, "A failed decoding changes nothing")
+	require.Equal(t, "xyz", segment.Query(0, 2))
+	require.EqualValues(t, 40, lazy.Query(0, 3))
+}
+
+func TestBinary(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	values := randomValues(rng, 3000)
+	fenwick := TDARangeQuery.CreateFenwickTree(values)
+	segment := TDARangeQuery.CreateSegmentTree(values, sum, 0)
+	lazy := TDARangeQuery.CreateLazySegmentTree(values, sum, 0, addToSum, sum)
+	lazy.Update(100, 200, 7)
+	values[0] += 1000
+	fenwick.Add(0, 1000)
+	segment.Set(0, values[0])
+	lazy.Set(0, lazy.Get(0)+1000)
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(lazy))
+	data := buffer.Bytes()
+	copiedLazy := TDARangeQuery.CreateLazySegmentTree([]int{1}, sum, 0, addToSum, sum)
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copiedLazy))
+	require.EqualValues(t, 3000, copiedLazy.Length())
+	require.Equal(t, lazy.Query(0, 2999), copiedLazy.Query(0, 2999))
+	copiedLazy.Update(0, 2999, 1)
+	require.Equal(t, lazy.Query(0, 2999)+3000, copiedLazy.Query(0, 2999), "The tree keeps its functions")
+
+	copiedFenwick := TDARangeQuery.CreateFenwickTree([]int{})
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copiedFenwick), "Any tree reads the others")
+	require.Equal(t, lazy.Query(0, 2999), copiedFenwick.PrefixSum(3000))
+
+	binary, err := segment.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+	copiedSegment := TDARangeQuery.CreateSegmentTree([]int{}, sum, 0)
+	require.NoError(t, copiedSegment.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary))
+	for i := 0; i < 3000; i += 97 {
+		require.Equal(t, fenwick.Get(i), copiedSegment.Get(i))
+	}
+
+	require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(copiedFenwick))
+	require.Error(t, copiedSegment.(encoding.BinaryUnmarshaler).UnmarshalBinary(binary[:len(binary)/2]))
+	require.EqualValues(t, 3000, copiedFenwick.Length(), "A failed read changes nothing")
+	require.EqualValues(t, 3000, copiedSegment.Length())
+}
@@ -0,0 +1,52 @@
+package set
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "set"
+
+// -------------------- BINARY PRIMITIVES --------------------
+
+// WriteTo writes a binary snapshot of the set, with its elements in the order Iterate visits them, encoding them
+// with encoding/gob.
+func (set *dictionarySet[T]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, set.Count(), set.Iterate)
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the elements of the set with those of a snapshot written by WriteTo, reading nothing past its
+// end. If the snapshot cannot be read, it returns an error without changing the set.
+func (set *dictionarySet[T]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := createDictionarySet(set.create)
+	if err := snapshot.Read(reader, read.Add); err != nil {
+		return reader.BytesRead(), err
+	}
+	set.elements = read.elements
+	return reader.BytesRead(), nil
+}
+
+func (set *dictionarySet[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(set)
+}
+
+func (set *dictionarySet[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, set)
+}
+
+func (set *dictionarySet[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(set)
+}
+
+func (set *dictionarySet[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, set)
+}
@@ -0,0 +1,144 @@
+package set
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+const _PANIC_SET_MSG = "The element does not belong to the set"
+
+// dictionarySet stores its elements as the keys of a dictionary. create builds an empty dictionary of the same
+// kind, so that the algebra operations return sets of the same implementation.
+type dictionarySet[T any] struct {
+	elements TDADictionary.Dictionary[T, struct{}]
+	create   func() TDADictionary.Dictionary[T, struct{}]
+}
+
+type iterDictionarySet[T any] struct {
+	iter TDADictionary.DictionaryIterator[T, struct{}]
+}
+
+// CreateHashSet creates a set backed by a hash dictionary, using cmp to tell whether two elements are equal.
+func CreateHashSet[T any](cmp func(T, T) bool) Set[T] {
+	return createDictionarySet(func() TDADictionary.Dictionary[T, struct{}] {
+		return TDADictionary.CreateHash[T, struct{}](cmp)
+	})
+}
+
+// CreateOrderedSet creates a set backed by an ordered dictionary. Iteration yields the elements in the order
+// given by cmp.
+func CreateOrderedSet[T any](cmp func(T, T) int) Set[T] {
+	return createDictionarySet(func() TDADictionary.Dictionary[T, struct{}] {
+		return TDADictionary.CreateAVL[T, struct{}](cmp)
+	})
+}
+
+func createDictionarySet[T any](create func() TDADictionary.Dictionary[T, struct{}]) *dictionarySet[T] {
+	return &dictionarySet[T]{elements: create(), create: create}
+}
+
+// -------------------- SET PRIMITIVES --------------------
+
+func (set *dictionarySet[T]) Add(element T) {
+	set.elements.Save(element, struct{}{})
+}
+
+func (set *dictionarySet[T]) Remove(element T) {
+	if !set.elements.Belongs(element) {
+		panic(_PANIC_SET_MSG)
+	}
+	set.elements.Delete(element)
+}
+
+func (set *dictionarySet[T]) Contains(element T) bool {
+	return set.elements.Belongs(element)
+}
+
+func (set *dictionarySet[T]) Count() int {
+	return set.elements.Count()
+}
+
+func (set *dictionarySet[T]) Iterate(visit func(T) bool) {
+	set.elements.Iterate(func(element T, _ struct{}) bool {
+		return visit(element)
+	})
+}
+
+func (set *dictionarySet[T]) Iterator() SetIterator[T] {
+	return &iterDictionarySet[T]{iter: set.elements.Iterator()}
+}
+
+// -------------------- SET ALGEBRA --------------------
+
+func (set *dictionarySet[T]) Union(other Set[T]) Set[T] {
+	result := set.filter(func(T) bool { return true })
+	other.Iterate(func(element T) bool {
+		result.Add(element)
+		return true
+	})
+	return result
+}
+
+func (set *dictionarySet[T]) Intersection(other Set[T]) Set[T] {
+	return set.filter(other.Contains)
+}
+
+func (set *dictionarySet[T]) Difference(other Set[T]) Set[T] {
+	return set.filter(func(element T) bool { return !other.Contains(element) })
+}
+
+func (set *dictionarySet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := set.Difference(other)
+	other.Iterate(func(element T) bool {
+		if !set.Contains(element) {
+			result.Add(element)
+		}
+		return true
+	})
+	return result
+}
+
+func (set *dictionarySet[T]) IsSubset(other Set[T]) bool {
+	if set.Count() > other.Count() {
+		return false
+	}
+
+	subset := true
+	set.Iterate(func(element T) bool {
+		subset = other.Contains(element)
+		return subset
+	})
+	return subset
+}
+
+func (set *dictionarySet[T]) Equal(other Set[T]) bool {
+	return set.Count() == other.Count() && set.IsSubset(other)
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterDictionarySet[T]) HasNext() bool {
+	return iter.iter.HasNext()
+}
+
+func (iter *iterDictionarySet[T]) Current() T {
+	element, _ := iter.iter.Current()
+	return element
+}
+
+func (iter *iterDictionarySet[T]) Next() {
+	iter.iter.Next()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// filter returns a new set of the same implementation with the elements that satisfy keep.
+func (set *dictionarySet[T]) filter(keep func(T) bool) *dictionarySet[T] {
+	result := createDictionarySet(set.create)
+	set.Iterate(func(element T) bool {
+		if keep(element) {
+			result.Add(element)
+		}
+		return true
+	})
+	return result
+}
@@ -0,0 +1,84 @@
+package set
+
+import (
+	TDADictionary "adts/dictionary"
+	"cmp"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// -------------------- FORMAT PRIMITIVES --------------------
+
+// String formats the set like set{1 2 3}. The elements of an ordered set keep their order, and those of a hash set
+// are sorted as fmt sorts the keys of maps: numbers, strings and booleans by their value, and the rest by their text.
+func (set *dictionarySet[T]) String() string {
+	return "set{" + strings.Join(set.texts("%v"), " ") + "}"
+}
+
+// GoString formats the set as Go syntax, like set.HashSet[int]{1, 2, 3}.
+func (set *dictionarySet[T]) GoString() string {
+	name := "set.HashSet"
+	if set.isOrdered() {
+		name = "set.OrderedSet"
+	}
+	return fmt.Sprintf("%s[%v]{%s}", name, reflect.TypeFor[T](), strings.Join(set.texts("%#v"), ", "))
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func (set *dictionarySet[T]) texts(verb string) []string {
+	var elements []T
+	set.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	texts := make([]string, len(elements))
+	for i, element := range elements {
+		texts[i] = fmt.Sprintf(verb, element)
+	}
+	if !set.isOrdered() {
+		sort.Sort(byElement[T]{elements, texts})
+	}
+	return texts
+}
+
+// byElement sorts the elements of a set together with their texts, as fmt sorts the keys of maps
+type byElement[T any] struct {
+	elements []T
+	texts    []string
+}
+
+func (sorting byElement[T]) Len() int {
+	return len(sorting.elements)
+}
+
+func (sorting byElement[T]) Swap(i, j int) {
+	sorting.elements[i], sorting.elements[j] = sorting.elements[j], sorting.elements[i]
+	sorting.texts[i], sorting.texts[j] = sorting.texts[j], sorting.texts[i]
+}
+
+func (sorting byElement[T]) Less(i, j int) bool {
+	x, y := reflect.ValueOf(sorting.elements[i]), reflect.ValueOf(sorting.elements[j])
+	if x.Kind() == y.Kind() {
+		switch x.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return x.Int() < y.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return x.Uint() < y.Uint()
+		case reflect.Float32, reflect.Float64:
+			return cmp.Less(x.Float(), y.Float())
+		case reflect.String:
+			return x.String() < y.String()
+		case reflect.Bool:
+			return !x.Bool() && y.Bool()
+		}
+	}
+	return sorting.texts[i] < sorting.texts[j]
+}
+
+func (set *dictionarySet[T]) isOrdered() bool {
+	_, ordered := set.elements.(TDADictionary.OrderedDictionary[T, struct{}])
+	return ordered
+}
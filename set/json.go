@@ -0,0 +1,37 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// -------------------- JSON PRIMITIVES --------------------
+
+// MarshalJSON encodes the set as a JSON array of its elements, in the order Iterate visits them.
+func (set *dictionarySet[T]) MarshalJSON() ([]byte, error) {
+	elements := make([]T, 0, set.Count())
+	set.Iterate(func(element T) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return json.Marshal(elements)
+}
+
+// UnmarshalJSON replaces the elements of the set with those of a JSON array, where repeated elements are added
+// once. A JSON null leaves the set as it is, and an array that cannot be decoded returns an error without
+// changing it.
+func (set *dictionarySet[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	set.elements = set.create()
+	for _, element := range elements {
+		set.Add(element)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package set
+
+// Set is a generic interface representing a collection of distinct elements of type T.
+type Set[T any] interface {
+
+	// Add inserts the element in the set. If it already belongs to the set, nothing changes.
+	Add(T)
+
+	// Remove deletes the element from the set.
+	// If the element does not belong to the set, it panics with "The element does not belong to the set".
+	Remove(T)
+
+	// Contains returns true if the element belongs to the set, false otherwise.
+	Contains(T) bool
+
+	// Count returns the number of elements in the set.
+	Count() int
+
+	// Iterate applies the visit function to each element until:
+	// - all elements are visited, or
+	// - visit returns false
+	Iterate(visit func(T) bool)
+
+	// Iterator returns a SetIterator for traversing the set.
+	Iterator() SetIterator[T]
+
+	// Union returns a new set, of the same implementation, with the elements that belong to either set.
+	Union(other Set[T]) Set[T]
+
+	// Intersection returns a new set, of the same implementation, with the elements that belong to both sets.
+	Intersection(other Set[T]) Set[T]
+
+	// Difference returns a new set, of the same implementation, with the elements of this set that do not
+	// belong to other.
+	Difference(other Set[T]) Set[T]
+
+	// SymmetricDifference returns a new set, of the same implementation, with the elements that belong to
+	// exactly one of the sets.
+	SymmetricDifference(other Set[T]) Set[T]
+
+	// IsSubset returns true if every element of this set belongs to other.
+	IsSubset(other Set[T]) bool
+
+	// Equal returns true if both sets have the same elements.
+	Equal(other Set[T]) bool
+}
+
+// SetIterator is an interface to iterate over a set.
+type SetIterator[T any] interface {
+
+	// HasNext indicates if there is a current element to see.
+	HasNext() bool
+
+	// Current returns the current element in the iteration.
+	// Pre: There is a current element.
+	Current() T
+
+	// Next moves the iterator to the next element.
+	// Pre: There is a next element.
+	Next()
+}
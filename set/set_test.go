@@ -0,0 +1,239 @@
+package set_test
+
+import (
+	TDASet "adts/set"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_SET_MSG  = "The element does not belong to the set"
+	_PANIC_ITER_MSG = "The iterator has finished iterating"
+	VOLUME_SIZE     = 10000
+)
+
+var constructors = map[string]func() TDASet.Set[int]{
+	"HashSet": func() TDASet.Set[int] {
+		return TDASet.CreateHashSet(func(a, b int) bool { return a == b })
+	},
+	"OrderedSet": func() TDASet.Set[int] {
+		return TDASet.CreateOrderedSet(func(a, b int) int { return a - b })
+	},
+}
+
+func createSet(create func() TDASet.Set[int], elements ...int) TDASet.Set[int] {
+	set := create()
+	for _, element := range elements {
+		set.Add(element)
+	}
+	return set
+}
+
+func elementsOf(set TDASet.Set[int]) []int {
+	var elements []int
+	set.Iterate(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	slices.Sort(elements)
+	return elements
+}
+
+func TestEmptySet(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			set := create()
+			require.EqualValues(t, 0, set.Count())
+			require.False(t, set.Contains(0))
+			require.PanicsWithValue(t, _PANIC_SET_MSG, func() { set.Remove(0) })
+
+			iter := set.Iterator()
+			require.False(t, iter.HasNext())
+			require.PanicsWithValue(t, _PANIC_ITER_MSG, func() { iter.Current() })
+			require.PanicsWithValue(t, _PANIC_ITER_MSG, func() { iter.Next() })
+		})
+	}
+}
+
+func TestAddAndRemove(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			set := create()
+			set.Add(1)
+			set.Add(2)
+			set.Add(1)
+			require.EqualValues(t, 2, set.Count())
+			require.True(t, set.Contains(1))
+			require.True(t, set.Contains(2))
+
+			set.Remove(1)
+			require.False(t, set.Contains(1))
+			require.EqualValues(t, 1, set.Count())
+			require.PanicsWithValue(t, _PANIC_SET_MSG, func() { set.Remove(1) })
+		})
+	}
+}
+
+func TestIterator(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			set := createSet(create, 3, 1, 2)
+			var elements []int
+			for iter := set.Iterator(); iter.HasNext(); iter.Next() {
+				elements = append(elements, iter.Current())
+			}
+			slices.Sort(elements)
+			require.Equal(t, []int{1, 2, 3}, elements)
+		})
+	}
+}
+
+func TestOrderedSetIteratesInOrder(t *testing.T) {
+	set := createSet(constructors["OrderedSet"], 5, 3, 9, 1, 7)
+	var elements []int
+	for iter := set.Iterator(); iter.HasNext(); iter.Next() {
+		elements = append(elements, iter.Current())
+	}
+	require.Equal(t, []int{1, 3, 5, 7, 9}, elements)
+}
+
+func TestAlgebra(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			first := createSet(create, 1, 2, 3, 4)
+			second := createSet(create, 3, 4, 5)
+
+			require.Equal(t, []int{1, 2, 3, 4, 5}, elementsOf(first.Union(second)))
+			require.Equal(t, []int{3, 4}, elementsOf(first.Intersection(second)))
+			require.Equal(t, []int{1, 2}, elementsOf(first.Difference(second)))
+			require.Equal(t, []int{5}, elementsOf(second.Difference(first)))
+			require.Equal(t, []int{1, 2, 5}, elementsOf(first.SymmetricDifference(second)))
+
+			// The operands are left untouched
+			require.Equal(t, []int{1, 2, 3, 4}, elementsOf(first))
+			require.Equal(t, []int{3, 4, 5}, elementsOf(second))
+		})
+	}
+}
+
+func TestSubsetAndEqual(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			empty := create()
+			small := createSet(create, 1, 2)
+			big := createSet(create, 2, 1, 3)
+
+			require.True(t, empty.IsSubset(small))
+			require.True(t, small.IsSubset(big))
+			require.False(t, big.IsSubset(small))
+			require.True(t, small.IsSubset(small))
+
+			require.True(t, small.Equal(createSet(create, 2, 1)))
+			require.False(t, small.Equal(big))
+			require.False(t, small.Equal(createSet(create, 1, 3)))
+			require.True(t, empty.Equal(create()))
+		})
+	}
+}
+
+func TestAlgebraBetweenImplementations(t *testing.T) {
+	hash := createSet(constructors["HashSet"], 4, 2, 6)
+	ordered := createSet(constructors["OrderedSet"], 6, 8)
+
+	union := ordered.Union(hash)
+	var elements []int
+	union.Iterate(func(element int) bool {
+		elements = append(elements, element)
+		return true
+	})
+	require.Equal(t, []int{2, 4, 6, 8}, elements, "The result keeps the implementation of the receiver")
+	require.True(t, hash.Intersection(ordered).Equal(createSet(constructors["OrderedSet"], 6)))
+}
+
+func TestVolume(t *testing.T) {
+	for name, create := range constructors {
+		t.Run(name, func(t *testing.T) {
+			evens, odds := create(), create()
+			for i := 0; i < VOLUME_SIZE; i++ {
+				if i%2 == 0 {
+					evens.Add(i)
+				} else {
+					odds.Add(i)
+				}
+			}
+
+			require.EqualValues(t, VOLUME_SIZE, evens.Union(odds).Count())
+			require.EqualValues(t, 0, evens.Intersection(odds).Count())
+			require.True(t, evens.Difference(odds).Equal(evens))
+
+			for i := 0; i < VOLUME_SIZE; i += 2 {
+				evens.Remove(i)
+			}
+			require.EqualValues(t, 0, evens.Count())
+		})
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	for name, create := range constructors {
+		set := createSet(create, 5, 1, 3)
+		data, err := json.Marshal(set)
+		require.NoError(t, err, name)
+		var elements []int
+		require.NoError(t, json.Unmarshal(data, &elements), name)
+		require.ElementsMatch(t, []int{1, 3, 5}, elements, name)
+
+		copied := createSet(create, 7)
+		require.NoError(t, json.Unmarshal([]byte(`[4,2,4]`), copied), name)
+		require.Equal(t, []int{2, 4}, elementsOf(copied), name)
+
+		require.NoError(t, json.Unmarshal([]byte(`null`), copied), name)
+		require.Error(t, json.Unmarshal([]byte(`[1,"two"]`), copied), name)
+		require.Equal(t, []int{2, 4}, elementsOf(copied), name)
+	}
+
+	ordered := createSet(constructors["OrderedSet"], 5, 1, 3)
+	data, err := json.Marshal(ordered)
+	require.NoError(t, err)
+	require.Equal(t, `[1,3,5]`, string(data))
+}
+
+func TestSetBinary(t *testing.T) {
+	for name, create := range constructors {
+		set := createSet(create, 5, 1, 3)
+		var buffer bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buffer).Encode(set), name)
+		data := buffer.Bytes()
+
+		for other, createOther := range constructors {
+			copied := createSet(createOther, 7)
+			require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied), "%s into %s", name, other)
+			require.Equal(t, []int{1, 3, 5}, elementsOf(copied), "%s into %s", name, other)
+			copied.Add(2)
+			require.Equal(t, []int{1, 2, 3, 5}, elementsOf(copied), "%s into %s", name, other)
+		}
+	}
+}
+
+func TestSetFormat(t *testing.T) {
+	for name, create := range constructors {
+		set := createSet(create)
+		require.Equal(t, "set{}", fmt.Sprint(set), name)
+		require.Equal(t, "set."+name+"[int]{}", fmt.Sprintf("%#v", set), name)
+		set = createSet(create, 5, 1, 3)
+		require.Equal(t, "set{1 3 5}", fmt.Sprint(set), name)
+		require.Equal(t, "set."+name+"[int]{1, 3, 5}", fmt.Sprintf("%#v", set), name)
+	}
+
+	ordered := createSet(constructors["OrderedSet"], 10, 2)
+	require.Equal(t, "set{2 10}", fmt.Sprint(ordered), "An ordered set keeps its order")
+	hash := createSet(constructors["HashSet"], 10, 2)
+	require.Equal(t, "set{2 10}", fmt.Sprint(hash), "A hash set is sorted by value, as fmt sorts maps")
+	require.Equal(t, "set.HashSet[int]{2, 10}", fmt.Sprintf("%#v", hash))
+}
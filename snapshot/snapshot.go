@@ -0,0 +1,217 @@
+// Package snapshot implements the binary format the structures of this module are saved in and restored from.
+//
+// A snapshot starts with a header made of the bytes "ADTS", the version of the format and the kind of structure,
+// written as its length in one byte followed by its name. Then comes a gob stream with sections of elements, each
+// one being the number of elements followed by the elements themselves, in chunks of at most 1024. The sections
+// are written and read one chunk at a time, so that neither side keeps the whole snapshot in memory.
+package snapshot
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// Version is the version of the format written by this package. Snapshots of every version up to it can be
+	// read.
+	Version = 1
+
+	_MAGIC      = "ADTS"
+	_CHUNK_SIZE = 1024
+)
+
+var (
+	// ErrFormat is returned when reading data that is not a snapshot, or that is damaged.
+	ErrFormat = errors.New("snapshot: the data is not a valid snapshot")
+
+	// ErrVersion is returned when reading a snapshot written by a newer version of the format.
+	ErrVersion = errors.New("snapshot: the snapshot was written by a newer version of the format")
+
+	// ErrKind is returned when reading a snapshot of another kind of structure.
+	ErrKind = errors.New("snapshot: the snapshot holds another kind of structure")
+)
+
+// Pair is a key and its value, as the dictionaries write them.
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// Writer writes a snapshot to an io.Writer.
+type Writer struct {
+	out     *countingWriter
+	encoder *gob.Encoder
+}
+
+// Reader reads a snapshot from an io.Reader, without reading past its end.
+type Reader struct {
+	in      *countingReader
+	decoder *gob.Decoder
+	version int
+}
+
+// NewWriter writes the header of a snapshot of the kind of structure and returns a Writer for its sections.
+func NewWriter(w io.Writer, kind string) (*Writer, error) {
+	if len(kind) > 255 {
+		return nil, fmt.Errorf("snapshot: the kind %q is too long", kind)
+	}
+	out := &countingWriter{w: w}
+	header := append([]byte(_MAGIC), Version, byte(len(kind)))
+	if _, err := out.Write(append(header, kind...)); err != nil {
+		return nil, err
+	}
+	return &Writer{out, gob.NewEncoder(out)}, nil
+}
+
+// NewReader reads the header of a snapshot, checking that it holds the kind of structure, and returns a Reader for
+// its sections.
+func NewReader(r io.Reader, kind string) (*Reader, error) {
+	in := &countingReader{r: r}
+	header := make([]byte, len(_MAGIC)+2)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return nil, unexpected(err)
+	}
+	if string(header[:len(_MAGIC)]) != _MAGIC || header[len(_MAGIC)] == 0 {
+		return nil, ErrFormat
+	}
+	version := int(header[len(_MAGIC)])
+	if version > Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, version)
+	}
+	name := make([]byte, header[len(_MAGIC)+1])
+	if _, err := io.ReadFull(in, name); err != nil {
+		return nil, unexpected(err)
+	}
+	if string(name) != kind {
+		return nil, fmt.Errorf("%w: expected %q but found %q", ErrKind, kind, name)
+	}
+	return &Reader{in, gob.NewDecoder(in), version}, nil
+}
+
+// BytesWritten returns the number of bytes written so far, the header included.
+func (writer *Writer) BytesWritten() int64 {
+	return writer.out.count
+}
+
+// Version returns the version of the format the snapshot was written in.
+func (reader *Reader) Version() int {
+	return reader.version
+}
+
+// BytesRead returns the number of bytes read so far, the header included.
+func (reader *Reader) BytesRead() int64 {
+	return reader.in.count
+}
+
+// Write writes a section with the count elements that iterate visits, which must be exactly count.
+func Write[T any](writer *Writer, count int, iterate func(visit func(T) bool)) error {
+	if err := writer.encoder.Encode(count); err != nil {
+		return err
+	}
+
+	chunk := make([]T, 0, min(count, _CHUNK_SIZE))
+	written := 0
+	var err error
+	flush := func() {
+		written += len(chunk)
+		err = writer.encoder.Encode(chunk)
+		chunk = chunk[:0]
+	}
+	iterate(func(element T) bool {
+		if chunk = append(chunk, element); len(chunk) == _CHUNK_SIZE {
+			flush()
+		}
+		return err == nil
+	})
+	if err == nil && len(chunk) > 0 {
+		flush()
+	}
+	if err == nil && written != count {
+		err = fmt.Errorf("snapshot: expected %d elements but found %d", count, written)
+	}
+	return err
+}
+
+// Read reads a section, passing every element to add in the order they were written.
+func Read[T any](reader *Reader, add func(T)) error {
+	var count int
+	if err := reader.decoder.Decode(&count); err != nil {
+		return unexpected(err)
+	}
+	if count < 0 {
+		return ErrFormat
+	}
+
+	for read := 0; read < count; {
+		var chunk []T
+		if err := reader.decoder.Decode(&chunk); err != nil {
+			return unexpected(err)
+		}
+		if len(chunk) == 0 || read+len(chunk) > count {
+			return ErrFormat
+		}
+		for _, element := range chunk {
+			add(element)
+		}
+		read += len(chunk)
+	}
+	return nil
+}
+
+// Marshal returns the snapshot the value writes, to implement MarshalBinary and GobEncode with its WriteTo method.
+func Marshal(value io.WriterTo) ([]byte, error) {
+	var buffer bytes.Buffer
+	if _, err := value.WriteTo(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Unmarshal reads the snapshot into the value, to implement UnmarshalBinary and GobDecode with its ReadFrom method.
+func Unmarshal(data []byte, value io.ReaderFrom) error {
+	_, err := value.ReadFrom(bytes.NewReader(data))
+	return err
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// unexpected reports a snapshot that ends too soon as io.ErrUnexpectedEOF, since an empty one is not valid either
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+type countingWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (out *countingWriter) Write(data []byte) (int, error) {
+	n, err := out.w.Write(data)
+	out.count += int64(n)
+	return n, err
+}
+
+// countingReader is an io.ByteReader, so that gob reads exactly the bytes of every message instead of buffering
+// the ones that follow the snapshot
+type countingReader struct {
+	r     io.Reader
+	count int64
+}
+
+func (in *countingReader) Read(data []byte) (int, error) {
+	n, err := in.r.Read(data)
+	in.count += int64(n)
+	return n, err
+}
+
+func (in *countingReader) ReadByte() (byte, error) {
+	var data [1]byte
+	_, err := io.ReadFull(in, data[:])
+	return data[0], err
+}
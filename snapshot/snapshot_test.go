@@ -0,0 +1,115 @@
+package snapshot_test
+
+import (
+	"adts/snapshot"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeSection(t *testing.T, w io.Writer, kind string, elements []int) int64 {
+	writer, err := snapshot.NewWriter(w, kind)
+	require.NoError(t, err)
+	require.NoError(t, snapshot.Write(writer, len(elements), func(visit func(int) bool) {
+		for _, element := range elements {
+			if !visit(element) {
+				return
+			}
+		}
+	}))
+	return writer.BytesWritten()
+}
+
+func readSection(r io.Reader, kind string) ([]int, int64, error) {
+	reader, err := snapshot.NewReader(r, kind)
+	if err != nil {
+		return nil, 0, err
+	}
+	elements := []int{}
+	err = snapshot.Read(reader, func(element int) {
+		elements = append(elements, element)
+	})
+	return elements, reader.BytesRead(), err
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 1023, 1024, 1025, 5000} {
+		elements := make([]int, n)
+		for i := range elements {
+			elements[i] = i * i
+		}
+		var buffer bytes.Buffer
+		written := writeSection(t, &buffer, "numbers", elements)
+		require.EqualValues(t, buffer.Len(), written)
+		require.Equal(t, "ADTS\x01\x07numbers", buffer.String()[:13], "The header tells the version and the kind")
+
+		read, n, err := readSection(&buffer, "numbers")
+		require.NoError(t, err)
+		require.Equal(t, elements, read)
+		require.Equal(t, written, n)
+	}
+}
+
+func TestSnapshotDoesNotReadPastItsEnd(t *testing.T) {
+	var buffer bytes.Buffer
+	writeSection(t, &buffer, "first", []int{1, 2, 3})
+	writeSection(t, &buffer, "second", []int{4, 5})
+	buffer.WriteString("rest")
+
+	read, _, err := readSection(&buffer, "first")
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, read)
+	read, _, err = readSection(&buffer, "second")
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 5}, read)
+	require.Equal(t, "rest", buffer.String())
+}
+
+func TestSnapshotVersion(t *testing.T) {
+	var buffer bytes.Buffer
+	writer, err := snapshot.NewWriter(&buffer, "numbers")
+	require.NoError(t, err)
+	require.NoError(t, snapshot.Write(writer, 0, func(func(int) bool) {}))
+	reader, err := snapshot.NewReader(bytes.NewReader(buffer.Bytes()), "numbers")
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Version, reader.Version())
+
+	newer := buffer.Bytes()
+	newer[4] = snapshot.Version + 1
+	_, _, err = readSection(bytes.NewReader(newer), "numbers")
+	require.ErrorIs(t, err, snapshot.ErrVersion)
+}
+
+func TestSnapshotInvalidInput(t *testing.T) {
+	var buffer bytes.Buffer
+	writeSection(t, &buffer, "numbers", []int{1, 2, 3})
+	valid := buffer.Bytes()
+
+	_, _, err := readSection(bytes.NewReader(valid), "letters")
+	require.ErrorIs(t, err, snapshot.ErrKind)
+	_, _, err = readSection(bytes.NewReader([]byte("JSON\x01\x07numbers")), "numbers")
+	require.ErrorIs(t, err, snapshot.ErrFormat)
+	_, _, err = readSection(bytes.NewReader([]byte("ADTS\x00\x07numbers")), "numbers")
+	require.ErrorIs(t, err, snapshot.ErrFormat)
+	for _, end := range []int{0, 3, 8, len(valid) - 1} {
+		_, _, err = readSection(bytes.NewReader(valid[:end]), "numbers")
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF, "Truncated at %d", end)
+	}
+
+	reader, err := snapshot.NewReader(bytes.NewReader(valid), "numbers")
+	require.NoError(t, err)
+	require.Error(t, snapshot.Read(reader, func(string) {}), "The elements must be of the type they were written")
+}
+
+func TestSnapshotWriteChecksCount(t *testing.T) {
+	writer, err := snapshot.NewWriter(io.Discard, "numbers")
+	require.NoError(t, err)
+	require.Error(t, snapshot.Write(writer, 3, func(visit func(int) bool) {
+		visit(1)
+	}))
+
+	_, err = snapshot.NewWriter(io.Discard, string(make([]byte, 256)))
+	require.Error(t, err)
+}
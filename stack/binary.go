@@ -0,0 +1,58 @@
+package stack
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "stack"
+
+// ------------ BINARY ENCODING ------------ //
+
+// WriteTo writes a binary snapshot of the stack, from its bottom to its top, encoding the elements with
+// encoding/gob.
+func (s *dynamicStack[T]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	err = snapshot.Write(writer, s.size, func(visit func(T) bool) {
+		for _, element := range s.data[:s.size] {
+			if !visit(element) {
+				return
+			}
+		}
+	})
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the elements of the stack with those of a snapshot written by WriteTo, reading nothing past
+// its end. If the snapshot cannot be read, it returns an error without changing the stack.
+func (s *dynamicStack[T]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := NewDynamicStack[T]().(*dynamicStack[T])
+	if err := snapshot.Read(reader, read.Push); err != nil {
+		return reader.BytesRead(), err
+	}
+	*s = *read
+	return reader.BytesRead(), nil
+}
+
+func (s *dynamicStack[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(s)
+}
+
+func (s *dynamicStack[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, s)
+}
+
+func (s *dynamicStack[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(s)
+}
+
+func (s *dynamicStack[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, s)
+}
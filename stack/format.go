@@ -0,0 +1,30 @@
+package stack
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ------------ FORMATTING ------------ //
+
+// String formats the stack from its top to its bottom, like Stack[top: 3, 2, 1], or Stack[] if it is empty.
+func (s *dynamicStack[T]) String() string {
+	if s.size == 0 {
+		return "Stack[]"
+	}
+	texts := make([]string, s.size)
+	for i := range texts {
+		texts[i] = fmt.Sprint(s.data[s.size-1-i])
+	}
+	return "Stack[top: " + strings.Join(texts, ", ") + "]"
+}
+
+// GoString formats the stack as Go syntax, from its bottom to its top, like stack.DynamicStack[int]{1, 2, 3}.
+func (s *dynamicStack[T]) GoString() string {
+	texts := make([]string, s.size)
+	for i, element := range s.data[:s.size] {
+		texts[i] = fmt.Sprintf("%#v", element)
+	}
+	return fmt.Sprintf("stack.DynamicStack[%v]{%s}", reflect.TypeFor[T](), strings.Join(texts, ", "))
+}
@@ -0,0 +1,29 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ------------ JSON ENCODING ------------ //
+
+// MarshalJSON encodes the stack as a JSON array, from the bottom of the stack to its top.
+func (s *dynamicStack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.data[:s.size])
+}
+
+// UnmarshalJSON replaces the elements of the stack with those of a JSON array, whose last element ends up on top.
+// A JSON null leaves the stack as it is, and an array that cannot be decoded returns an error without changing it.
+func (s *dynamicStack[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	s.data = make([]T, max(INITIAL_CAPACITY, len(elements)))
+	s.size = copy(s.data, elements)
+	return nil
+}
@@ -2,6 +2,10 @@ package stack_test
 
 import (
 	"adts/stack" // ajusta la ruta según tu repositorio
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -113,3 +117,69 @@ func TestStackWithStructs(t *testing.T) {
 
 	require.True(t, stack.IsEmpty())
 }
+
+func TestStackJSON(t *testing.T) {
+	stack := stack.NewDynamicStack[Person]()
+	stack.Push(Person{"Bruno", 19})
+	stack.Push(Person{"Abril", 18})
+
+	data, err := json.Marshal(stack)
+	require.NoError(t, err)
+	require.Equal(t, `[{"Name":"Bruno","Age":19},{"Name":"Abril","Age":18}]`, string(data))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), stack))
+	require.Error(t, json.Unmarshal([]byte(`{"Name":"Rocco"}`), stack))
+	require.Equal(t, Person{"Abril", 18}, stack.Top())
+
+	stack.Pop()
+	require.NoError(t, json.Unmarshal(data, stack))
+	require.Equal(t, Person{"Abril", 18}, stack.Pop())
+	require.Equal(t, Person{"Bruno", 19}, stack.Pop())
+	require.True(t, stack.IsEmpty())
+}
+
+func TestStackJSONVolume(t *testing.T) {
+	elements := make([]int, 1000)
+	for i := range elements {
+		elements[i] = i
+	}
+	data, err := json.Marshal(elements)
+	require.NoError(t, err)
+
+	stack := stack.NewDynamicStack[int]()
+	require.NoError(t, json.Unmarshal(data, stack))
+	stack.Push(1000)
+	for i := 1000; i >= 0; i-- {
+		require.Equal(t, i, stack.Pop())
+	}
+	require.True(t, stack.IsEmpty())
+}
+
+func TestStackBinary(t *testing.T) {
+	stack := stack.NewDynamicStack[Person]()
+	stack.Push(Person{"Bruno", 19})
+	stack.Push(Person{"Abril", 18})
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(stack))
+	stack.Pop()
+	require.NoError(t, gob.NewDecoder(&buffer).Decode(stack))
+	require.Equal(t, Person{"Abril", 18}, stack.Pop())
+	require.Equal(t, Person{"Bruno", 19}, stack.Pop())
+	require.True(t, stack.IsEmpty())
+
+	require.Error(t, gob.NewDecoder(bytes.NewReader([]byte("ADTS"))).Decode(stack))
+}
+
+func TestStackFormat(t *testing.T) {
+	stack := stack.NewDynamicStack[int]()
+	require.Equal(t, "Stack[]", fmt.Sprint(stack))
+	require.Equal(t, "stack.DynamicStack[int]{}", fmt.Sprintf("%#v", stack))
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	require.Equal(t, "Stack[top: 3, 2, 1]", fmt.Sprint(stack))
+	require.Equal(t, "stack.DynamicStack[int]{1, 2, 3}", fmt.Sprintf("%#v", stack))
+	stack.Pop()
+	require.Equal(t, "Stack[top: 2, 1]", fmt.Sprint(stack))
+}
@@ -0,0 +1,75 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+	"adts/snapshot"
+	"io"
+)
+
+// -------------------- BINARY PRIMITIVES --------------------
+
+// WriteTo writes a binary snapshot of the pairs of the trie, as TDADictionary.WriteDictionary does, with the keys in
+// lexicographic order.
+func (trie *byteTrie[V]) WriteTo(w io.Writer) (int64, error) {
+	return TDADictionary.WriteDictionary[string, V](w, trie)
+}
+
+// ReadFrom replaces the pairs of the trie with those of a snapshot written by any dictionary, or returns an error
+// without changing them.
+func (trie *byteTrie[V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateTrie[V]().(*byteTrie[V])
+	n, err := TDADictionary.ReadDictionary[string, V](r, read)
+	if err == nil {
+		*trie = *read
+	}
+	return n, err
+}
+
+// WriteTo writes a binary snapshot of the pairs of the tree, as TDADictionary.WriteDictionary does, with the keys
+// in lexicographic order.
+func (tree *radixTree[K, V]) WriteTo(w io.Writer) (int64, error) {
+	return TDADictionary.WriteDictionary[K, V](w, tree)
+}
+
+// ReadFrom replaces the pairs of the tree with those of a snapshot written by any dictionary, or returns an error
+// without changing them.
+func (tree *radixTree[K, V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateRadixTree[K, V]().(*radixTree[K, V])
+	n, err := TDADictionary.ReadDictionary[K, V](r, read)
+	if err == nil {
+		*tree = *read
+	}
+	return n, err
+}
+
+func (trie *byteTrie[V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(trie)
+}
+
+func (trie *byteTrie[V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, trie)
+}
+
+func (trie *byteTrie[V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(trie)
+}
+
+func (trie *byteTrie[V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, trie)
+}
+
+func (tree *radixTree[K, V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *radixTree[K, V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *radixTree[K, V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *radixTree[K, V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
@@ -0,0 +1,267 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAStack "adts/stack"
+	"sort"
+)
+
+const (
+	_PANIC_MESSAGE_DICTIONARY = "The key does not belong to the dictionary"
+	_PANIC_MESSAGE_ITER       = "The iterator has finished iterating"
+)
+
+// trieNode represents the prefix spelled by the labels from the root. children is sorted by label, and count is
+// the number of keys in the subtree, the node included.
+type trieNode[V any] struct {
+	label    byte
+	children []*trieNode[V]
+	value    V
+	hasValue bool
+	count    int
+}
+
+type byteTrie[V any] struct {
+	root *trieNode[V]
+}
+
+// pendingNode is a node waiting in the iterator stack, together with the key it represents.
+type pendingNode[V any] struct {
+	node *trieNode[V]
+	key  string
+}
+
+type iterByteTrie[V any] struct {
+	stack TDAStack.Stack[pendingNode[V]]
+}
+
+// CreateTrie creates an empty Trie with one node per byte of the keys.
+func CreateTrie[V any]() Trie[V] {
+	return &byteTrie[V]{root: &trieNode[V]{}}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (trie *byteTrie[V]) Save(key string, value V) {
+	path := []*trieNode[V]{trie.root}
+	current := trie.root
+	for i := 0; i < len(key); i++ {
+		current = current.childOrCreate(key[i])
+		path = append(path, current)
+	}
+
+	if !current.hasValue {
+		for _, node := range path {
+			node.count++
+		}
+	}
+	current.value = value
+	current.hasValue = true
+}
+
+func (trie *byteTrie[V]) Belongs(key string) bool {
+	node := trie.find(key)
+	return node != nil && node.hasValue
+}
+
+func (trie *byteTrie[V]) Get(key string) V {
+	node := trie.find(key)
+	if node == nil || !node.hasValue {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return node.value
+}
+
+func (trie *byteTrie[V]) Delete(key string) V {
+	path := []*trieNode[V]{trie.root}
+	for i := 0; i < len(key); i++ {
+		next := path[i].child(key[i])
+		if next == nil {
+			panic(_PANIC_MESSAGE_DICTIONARY)
+		}
+		path = append(path, next)
+	}
+
+	node := path[len(path)-1]
+	if !node.hasValue {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+
+	value := node.value
+	var zero V
+	node.value = zero
+	node.hasValue = false
+
+	for i := len(path) - 1; i >= 0; i-- {
+		path[i].count--
+		if i > 0 && path[i].count == 0 {
+			path[i-1].removeChild(path[i].label)
+		}
+	}
+	return value
+}
+
+func (trie *byteTrie[V]) Count() int {
+	return trie.root.count
+}
+
+func (trie *byteTrie[V]) Iterate(visit func(key string, value V) bool) {
+	trie.IteratePrefix("", visit)
+}
+
+func (trie *byteTrie[V]) Iterator() TDADictionary.DictionaryIterator[string, V] {
+	return trie.IteratorPrefix("")
+}
+
+// ----------------------- PREFIX PRIMITIVES -----------------------
+
+func (trie *byteTrie[V]) KeysWithPrefix(prefix string) TDAList.List[string] {
+	keys := TDAList.CreateLinkedList[string]()
+	trie.IteratePrefix(prefix, func(key string, _ V) bool {
+		keys.InsertLast(key)
+		return true
+	})
+	return keys
+}
+
+func (trie *byteTrie[V]) LongestPrefixOf(s string) (string, bool) {
+	length, found := -1, false
+	current := trie.root
+	for i := 0; current != nil; i++ {
+		if current.hasValue {
+			length, found = i, true
+		}
+		if i == len(s) {
+			break
+		}
+		current = current.child(s[i])
+	}
+
+	if !found {
+		return "", false
+	}
+	return s[:length], true
+}
+
+func (trie *byteTrie[V]) CountPrefix(prefix string) int {
+	node := trie.find(prefix)
+	if node == nil {
+		return 0
+	}
+	return node.count
+}
+
+func (trie *byteTrie[V]) IteratePrefix(prefix string, visit func(key string, value V) bool) {
+	node := trie.find(prefix)
+	if node == nil {
+		return
+	}
+	node.iterate([]byte(prefix), visit)
+}
+
+func (trie *byteTrie[V]) IteratorPrefix(prefix string) TDADictionary.DictionaryIterator[string, V] {
+	iter := &iterByteTrie[V]{stack: TDAStack.NewDynamicStack[pendingNode[V]]()}
+	if node := trie.find(prefix); node != nil {
+		iter.stack.Push(pendingNode[V]{node, prefix})
+		iter.advanceToValue()
+	}
+	return iter
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterByteTrie[V]) HasNext() bool {
+	return !iter.stack.IsEmpty()
+}
+
+func (iter *iterByteTrie[V]) Current() (string, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	top := iter.stack.Top()
+	return top.key, top.node.value
+}
+
+func (iter *iterByteTrie[V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	iter.expand(iter.stack.Pop())
+	iter.advanceToValue()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Node functions
+
+func (trie *byteTrie[V]) find(key string) *trieNode[V] {
+	current := trie.root
+	for i := 0; i < len(key) && current != nil; i++ {
+		current = current.child(key[i])
+	}
+	return current
+}
+
+func (node *trieNode[V]) search(label byte) int {
+	return sort.Search(len(node.children), func(i int) bool {
+		return node.children[i].label >= label
+	})
+}
+
+func (node *trieNode[V]) child(label byte) *trieNode[V] {
+	pos := node.search(label)
+	if pos < len(node.children) && node.children[pos].label == label {
+		return node.children[pos]
+	}
+	return nil
+}
+
+func (node *trieNode[V]) childOrCreate(label byte) *trieNode[V] {
+	pos := node.search(label)
+	if pos < len(node.children) && node.children[pos].label == label {
+		return node.children[pos]
+	}
+
+	child := &trieNode[V]{label: label}
+	node.children = append(node.children, nil)
+	copy(node.children[pos+1:], node.children[pos:])
+	node.children[pos] = child
+	return child
+}
+
+func (node *trieNode[V]) removeChild(label byte) {
+	pos := node.search(label)
+	node.children = append(node.children[:pos], node.children[pos+1:]...)
+}
+
+// iterate visits the subtree in lexicographic order, key being the bytes spelled up to node. It returns false
+// once visit asks to stop.
+func (node *trieNode[V]) iterate(key []byte, visit func(string, V) bool) bool {
+	if node.hasValue && !visit(string(key), node.value) {
+		return false
+	}
+	for _, child := range node.children {
+		if !child.iterate(append(key, child.label), visit) {
+			return false
+		}
+	}
+	return true
+}
+
+// External iterator functions
+
+// expand pushes the children of the pending node so that the smallest label ends on top.
+func (iter *iterByteTrie[V]) expand(pending pendingNode[V]) {
+	children := pending.node.children
+	for i := len(children) - 1; i >= 0; i-- {
+		iter.stack.Push(pendingNode[V]{children[i], pending.key + string([]byte{children[i].label})})
+	}
+}
+
+// advanceToValue expands the top of the stack until it holds a node with a value.
+func (iter *iterByteTrie[V]) advanceToValue() {
+	for !iter.stack.IsEmpty() && !iter.stack.Top().node.hasValue {
+		iter.expand(iter.stack.Pop())
+	}
+}
@@ -0,0 +1,72 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// -------------------- FORMAT PRIMITIVES --------------------
+
+// String formats the trie like map{car:1 cat:2}, with its keys in lexicographic order.
+func (trie *byteTrie[V]) String() string {
+	return TDADictionary.FormatDictionary[string, V](trie)
+}
+
+func (trie *byteTrie[V]) GoString() string {
+	return TDADictionary.GoFormatDictionary[string, V](fmt.Sprintf("trie.Trie[%v]", reflect.TypeFor[V]()), trie)
+}
+
+// Dump writes a node per byte of the keys, with the value of the nodes that end a key.
+func (trie *byteTrie[V]) Dump(w io.Writer) error {
+	children := func(node *trieNode[V]) []*trieNode[V] {
+		return node.children
+	}
+	label := func(node *trieNode[V]) string {
+		text := fmt.Sprintf("%q", node.label)
+		if node == trie.root {
+			text = fmt.Sprintf("trie: %d keys", node.count)
+		}
+		if node.hasValue {
+			text += fmt.Sprintf(" = %v", node.value)
+		}
+		return text
+	}
+	return TDADictionary.PrintTree(w, trie.root, children, label)
+}
+
+// String formats the tree like map{car:1 cat:2}, with its keys in lexicographic order and written as text even if
+// they are byte slices.
+func (tree *radixTree[K, V]) String() string {
+	texts := make([]string, 0, tree.root.count)
+	tree.Iterate(func(key K, value V) bool {
+		texts = append(texts, fmt.Sprintf("%s:%v", key, value))
+		return true
+	})
+	return "map{" + strings.Join(texts, " ") + "}"
+}
+
+func (tree *radixTree[K, V]) GoString() string {
+	return TDADictionary.GoFormatDictionary[K, V](fmt.Sprintf("trie.RadixTree[%v, %v]", reflect.TypeFor[K](), reflect.TypeFor[V]()), tree)
+}
+
+// Dump writes a node per edge of the tree, labeled with the bytes of the edge, with the value of the nodes that end
+// a key.
+func (tree *radixTree[K, V]) Dump(w io.Writer) error {
+	children := func(node *radixNode[V]) []*radixNode[V] {
+		return node.children
+	}
+	label := func(node *radixNode[V]) string {
+		text := fmt.Sprintf("%q", node.label)
+		if node == tree.root {
+			text = fmt.Sprintf("radix tree: %d keys", node.count)
+		}
+		if node.hasValue {
+			text += fmt.Sprintf(" = %v", node.value)
+		}
+		return text
+	}
+	return TDADictionary.PrintTree(w, tree.root, children, label)
+}
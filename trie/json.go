@@ -0,0 +1,26 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+// -------------------- JSON PRIMITIVES --------------------
+
+// MarshalJSON encodes the trie as a JSON object with its keys in lexicographic order.
+func (trie *byteTrie[V]) MarshalJSON() ([]byte, error) {
+	return TDADictionary.MarshalDictionary[string, V](trie)
+}
+
+func (trie *byteTrie[V]) UnmarshalJSON(data []byte) error {
+	return TDADictionary.UnmarshalDictionary[string, V](data, trie)
+}
+
+// MarshalJSON encodes the tree as a JSON object with its keys in lexicographic order if they are strings, and as
+// an array of [key, value] arrays, with every key in base64, if they are byte slices.
+func (tree *radixTree[K, V]) MarshalJSON() ([]byte, error) {
+	return TDADictionary.MarshalDictionary[K, V](tree)
+}
+
+func (tree *radixTree[K, V]) UnmarshalJSON(data []byte) error {
+	return TDADictionary.UnmarshalDictionary[K, V](data, tree)
+}
@@ -0,0 +1,369 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAStack "adts/stack"
+	"sort"
+	"strings"
+)
+
+// radixNode represents the key spelled by the edge labels from the root, where label is the edge that reaches the
+// node. Every node other than the root either holds a value or has at least two children, and children is sorted
+// by the first byte of their labels, which is different for each of them. count is the number of keys in the
+// subtree, the node included.
+type radixNode[V any] struct {
+	label    string
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+	count    int
+}
+
+// radixTree stores the keys as strings regardless of K, so byte slice keys are copied when saved and cannot be
+// modified from outside.
+type radixTree[K Key, V any] struct {
+	root *radixNode[V]
+}
+
+// pendingRadixNode is a node waiting in the iterator stack, together with the key it represents.
+type pendingRadixNode[V any] struct {
+	node *radixNode[V]
+	key  string
+}
+
+type iterRadixTree[K Key, V any] struct {
+	stack TDAStack.Stack[pendingRadixNode[V]]
+}
+
+// CreateRadixTree creates an empty RadixTree. Keys returned by the tree are always new copies, so byte slice keys
+// can be modified freely by the caller.
+func CreateRadixTree[K Key, V any]() RadixTree[K, V] {
+	return &radixTree[K, V]{root: &radixNode[V]{}}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (tree *radixTree[K, V]) Save(key K, value V) {
+	rest := string(key)
+	path := []*radixNode[V]{tree.root}
+	current := tree.root
+	for rest != "" {
+		pos := current.search(rest[0])
+		if pos == len(current.children) || current.children[pos].label[0] != rest[0] {
+			leaf := &radixNode[V]{label: rest}
+			current.insertChild(pos, leaf)
+			path = append(path, leaf)
+			current = leaf
+			break
+		}
+
+		child := current.children[pos]
+		common := commonPrefixLength(child.label, rest)
+		if common < len(child.label) {
+			child = child.split(common)
+			current.children[pos] = child
+		}
+		path = append(path, child)
+		current = child
+		rest = rest[common:]
+	}
+
+	if !current.hasValue {
+		for _, node := range path {
+			node.count++
+		}
+	}
+	current.value = value
+	current.hasValue = true
+}
+
+func (tree *radixTree[K, V]) Belongs(key K) bool {
+	path := tree.exactPath(string(key))
+	return path != nil && path[len(path)-1].hasValue
+}
+
+func (tree *radixTree[K, V]) Get(key K) V {
+	path := tree.exactPath(string(key))
+	if path == nil || !path[len(path)-1].hasValue {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return path[len(path)-1].value
+}
+
+func (tree *radixTree[K, V]) Delete(key K) V {
+	path := tree.exactPath(string(key))
+	if path == nil || !path[len(path)-1].hasValue {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+
+	node := path[len(path)-1]
+	value := node.value
+	var zero V
+	node.value = zero
+	node.hasValue = false
+
+	for _, ancestor := range path {
+		ancestor.count--
+	}
+	tree.compress(path)
+	return value
+}
+
+func (tree *radixTree[K, V]) Count() int {
+	return tree.root.count
+}
+
+func (tree *radixTree[K, V]) Iterate(visit func(key K, value V) bool) {
+	tree.IteratePrefix(K(""), visit)
+}
+
+func (tree *radixTree[K, V]) Iterator() TDADictionary.DictionaryIterator[K, V] {
+	return tree.IteratorPrefix(K(""))
+}
+
+// ----------------------- PREFIX PRIMITIVES -----------------------
+
+func (tree *radixTree[K, V]) KeysWithPrefix(prefix K) TDAList.List[K] {
+	keys := TDAList.CreateLinkedList[K]()
+	tree.IteratePrefix(prefix, func(key K, _ V) bool {
+		keys.InsertLast(key)
+		return true
+	})
+	return keys
+}
+
+func (tree *radixTree[K, V]) LongestPrefixOf(s K) (K, bool) {
+	str := string(s)
+	length, consumed := -1, 0
+	current := tree.root
+	for {
+		if current.hasValue {
+			length = consumed
+		}
+		if consumed == len(str) {
+			break
+		}
+		child := current.child(str[consumed])
+		if child == nil || !strings.HasPrefix(str[consumed:], child.label) {
+			break
+		}
+		consumed += len(child.label)
+		current = child
+	}
+
+	if length < 0 {
+		var zero K
+		return zero, false
+	}
+	return K(str[:length]), true
+}
+
+func (tree *radixTree[K, V]) CountPrefix(prefix K) int {
+	path, _ := tree.locate(string(prefix))
+	if path == nil {
+		return 0
+	}
+	return path[len(path)-1].count
+}
+
+func (tree *radixTree[K, V]) IteratePrefix(prefix K, visit func(key K, value V) bool) {
+	path, key := tree.locate(string(prefix))
+	if path == nil {
+		return
+	}
+	path[len(path)-1].iterate(key, func(key string, value V) bool {
+		return visit(K(key), value)
+	})
+}
+
+func (tree *radixTree[K, V]) IteratorPrefix(prefix K) TDADictionary.DictionaryIterator[K, V] {
+	iter := &iterRadixTree[K, V]{stack: TDAStack.NewDynamicStack[pendingRadixNode[V]]()}
+	if path, key := tree.locate(string(prefix)); path != nil {
+		iter.stack.Push(pendingRadixNode[V]{path[len(path)-1], key})
+		iter.advanceToValue()
+	}
+	return iter
+}
+
+func (tree *radixTree[K, V]) DeletePrefix(prefix K) int {
+	path, _ := tree.locate(string(prefix))
+	if path == nil {
+		return 0
+	}
+
+	last := len(path) - 1
+	removed := path[last].count
+	if last == 0 {
+		tree.root = &radixNode[V]{}
+		return removed
+	}
+
+	for _, ancestor := range path[:last] {
+		ancestor.count -= removed
+	}
+	path[last-1].removeChild(path[last].label[0])
+	tree.compress(path[:last])
+	return removed
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterRadixTree[K, V]) HasNext() bool {
+	return !iter.stack.IsEmpty()
+}
+
+func (iter *iterRadixTree[K, V]) Current() (K, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	top := iter.stack.Top()
+	return K(top.key), top.node.value
+}
+
+func (iter *iterRadixTree[K, V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	iter.expand(iter.stack.Pop())
+	iter.advanceToValue()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Tree functions
+
+// exactPath returns the nodes from the root to the one that spells key, or nil if no node spells it.
+func (tree *radixTree[K, V]) exactPath(key string) []*radixNode[V] {
+	path := []*radixNode[V]{tree.root}
+	current := tree.root
+	for key != "" {
+		current = current.child(key[0])
+		if current == nil || !strings.HasPrefix(key, current.label) {
+			return nil
+		}
+		path = append(path, current)
+		key = key[len(current.label):]
+	}
+	return path
+}
+
+// locate returns the nodes from the root to the highest one whose subtree holds exactly the keys that start with
+// prefix, together with the key that node spells, which may be longer than prefix when it ends inside a label.
+// The path is nil if no key starts with prefix.
+func (tree *radixTree[K, V]) locate(prefix string) ([]*radixNode[V], string) {
+	path := []*radixNode[V]{tree.root}
+	current := tree.root
+	for rest := prefix; rest != ""; {
+		child := current.child(rest[0])
+		switch {
+		case child == nil:
+			return nil, ""
+		case strings.HasPrefix(rest, child.label):
+			rest = rest[len(child.label):]
+		case strings.HasPrefix(child.label, rest):
+			return append(path, child), prefix + child.label[len(rest):]
+		default:
+			return nil, ""
+		}
+		path = append(path, child)
+		current = child
+	}
+	return path, prefix
+}
+
+// compress restores the invariant of the nodes after the last node of path lost keys, its counts already updated:
+// an empty node is removed, and a node left with neither value nor siblings to branch is merged with its child.
+func (tree *radixTree[K, V]) compress(path []*radixNode[V]) {
+	last := len(path) - 1
+	if last > 0 && path[last].count == 0 {
+		path[last-1].removeChild(path[last].label[0])
+		last--
+	}
+	if node := path[last]; last > 0 && !node.hasValue && len(node.children) == 1 {
+		node.mergeChild()
+	}
+}
+
+func commonPrefixLength(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Node functions
+
+func (node *radixNode[V]) search(first byte) int {
+	return sort.Search(len(node.children), func(i int) bool {
+		return node.children[i].label[0] >= first
+	})
+}
+
+func (node *radixNode[V]) child(first byte) *radixNode[V] {
+	pos := node.search(first)
+	if pos < len(node.children) && node.children[pos].label[0] == first {
+		return node.children[pos]
+	}
+	return nil
+}
+
+func (node *radixNode[V]) insertChild(pos int, child *radixNode[V]) {
+	node.children = append(node.children, nil)
+	copy(node.children[pos+1:], node.children[pos:])
+	node.children[pos] = child
+}
+
+func (node *radixNode[V]) removeChild(first byte) {
+	pos := node.search(first)
+	node.children = append(node.children[:pos], node.children[pos+1:]...)
+}
+
+// split cuts the label of the node after length bytes, returning the new node that takes the first part and has
+// the node as its only child.
+func (node *radixNode[V]) split(length int) *radixNode[V] {
+	parent := &radixNode[V]{label: node.label[:length], children: []*radixNode[V]{node}, count: node.count}
+	node.label = node.label[length:]
+	return parent
+}
+
+// mergeChild absorbs the only child of the node, which must not hold a value.
+func (node *radixNode[V]) mergeChild() {
+	child := node.children[0]
+	node.label += child.label
+	node.children = child.children
+	node.value = child.value
+	node.hasValue = child.hasValue
+}
+
+// iterate visits the subtree in lexicographic order, key being the string spelled up to node. It returns false
+// once visit asks to stop.
+func (node *radixNode[V]) iterate(key string, visit func(string, V) bool) bool {
+	if node.hasValue && !visit(key, node.value) {
+		return false
+	}
+	for _, child := range node.children {
+		if !child.iterate(key+child.label, visit) {
+			return false
+		}
+	}
+	return true
+}
+
+// External iterator functions
+
+// expand pushes the children of the pending node so that the smallest label ends on top.
+func (iter *iterRadixTree[K, V]) expand(pending pendingRadixNode[V]) {
+	children := pending.node.children
+	for i := len(children) - 1; i >= 0; i-- {
+		iter.stack.Push(pendingRadixNode[V]{children[i], pending.key + children[i].label})
+	}
+}
+
+// advanceToValue expands the top of the stack until it holds a node with a value.
+func (iter *iterRadixTree[K, V]) advanceToValue() {
+	for !iter.stack.IsEmpty() && !iter.stack.Top().node.hasValue {
+		iter.expand(iter.stack.Pop())
+	}
+}
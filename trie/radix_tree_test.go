@@ -0,0 +1,290 @@
+package trie_test
+
+import (
+	TDADictionary "adts/dictionary"
+	TDATrie "adts/trie"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createWordRadixTree() TDATrie.RadixTree[string, int] {
+	tree := TDATrie.CreateRadixTree[string, int]()
+	for i, word := range WORDS {
+		tree.Save(word, i)
+	}
+	return tree
+}
+
+// countWords uses the tree only through the Dictionary interface, as any code written for CreateHash would.
+func countWords(dict TDADictionary.Dictionary[string, int], text string) {
+	for _, word := range strings.Fields(text) {
+		if dict.Belongs(word) {
+			dict.Save(word, dict.Get(word)+1)
+		} else {
+			dict.Save(word, 1)
+		}
+	}
+}
+
+func TestEmptyRadixTree(t *testing.T) {
+	tree := TDATrie.CreateRadixTree[string, int]()
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Belongs(""))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Get("a") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Delete("a") })
+	require.EqualValues(t, 0, tree.DeletePrefix(""))
+
+	_, found := tree.LongestPrefixOf("abc")
+	require.False(t, found)
+
+	iter := tree.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Current() })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Next() })
+}
+
+func TestRadixTreeDictionaryPrimitives(t *testing.T) {
+	tree := createWordRadixTree()
+	require.EqualValues(t, len(WORDS), tree.Count())
+	for i, word := range WORDS {
+		require.True(t, tree.Belongs(word))
+		require.EqualValues(t, i, tree.Get(word))
+	}
+	require.False(t, tree.Belongs("ca"), "Prefixes of keys are not keys")
+	require.False(t, tree.Belongs("carefully"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Get("ca") })
+
+	require.EqualValues(t, 0, tree.Delete("car"))
+	require.False(t, tree.Belongs("car"))
+	require.True(t, tree.Belongs("card"), "Deleting a key keeps the keys that extend it")
+	require.True(t, tree.Belongs("care"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Delete("car") })
+
+	require.EqualValues(t, 3, tree.Delete("careful"))
+	require.True(t, tree.Belongs("care"), "Deleting a key keeps its prefixes")
+	require.EqualValues(t, len(WORDS)-2, tree.Count())
+
+	tree.Save("ca", 50)
+	require.EqualValues(t, 50, tree.Get("ca"), "Saving in the middle of an edge splits it")
+	require.EqualValues(t, []string{"ca", "card", "care", "cat"}, listToSlice(tree.KeysWithPrefix("c")))
+}
+
+func TestRadixTreeIsDropInForHash(t *testing.T) {
+	text := "the quick brown fox jumps over the lazy dog the end"
+	hash := TDADictionary.CreateHash[string, int](func(a, b string) bool { return a == b })
+	tree := TDATrie.CreateRadixTree[string, int]()
+	countWords(hash, text)
+	countWords(tree, text)
+
+	require.EqualValues(t, hash.Count(), tree.Count())
+	hash.Iterate(func(key string, value int) bool {
+		require.EqualValues(t, value, tree.Get(key))
+		return true
+	})
+}
+
+func TestRadixTreeByteSliceKeys(t *testing.T) {
+	tree := TDATrie.CreateRadixTree[[]byte, int]()
+	key := []byte("key")
+	tree.Save(key, 1)
+	tree.Save([]byte{0x00, 0xff}, 2)
+	tree.Save([]byte("keyboard"), 3)
+
+	key[0] = 'm'
+	require.True(t, tree.Belongs([]byte("key")), "Saved keys do not change with the caller's slice")
+	require.False(t, tree.Belongs(key))
+
+	var keys [][]byte
+	tree.Iterate(func(key []byte, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Equal(t, [][]byte{{0x00, 0xff}, []byte("key"), []byte("keyboard")}, keys)
+
+	prefix, found := tree.LongestPrefixOf([]byte("keys"))
+	require.True(t, found)
+	require.Equal(t, []byte("key"), prefix)
+	require.EqualValues(t, 2, tree.DeletePrefix([]byte("ke")))
+	require.EqualValues(t, 1, tree.Count())
+}
+
+func TestRadixTreeIteratesInLexicographicOrder(t *testing.T) {
+	tree := createWordRadixTree()
+	expected := append([]string{}, WORDS...)
+	sort.Strings(expected)
+
+	var internal []string
+	tree.Iterate(func(key string, value int) bool {
+		require.EqualValues(t, WORDS[value], key)
+		internal = append(internal, key)
+		return true
+	})
+
+	var external []string
+	for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, WORDS[value], key)
+		external = append(external, key)
+	}
+
+	require.Equal(t, expected, internal)
+	require.Equal(t, expected, external)
+}
+
+func TestRadixTreePrefixQueries(t *testing.T) {
+	tree := createWordRadixTree()
+	require.Equal(t, []string{"car", "card", "care", "careful"}, listToSlice(tree.KeysWithPrefix("car")))
+	require.Equal(t, []string{"careful"}, listToSlice(tree.KeysWithPrefix("caref")), "Prefixes may end inside an edge")
+	require.Empty(t, listToSlice(tree.KeysWithPrefix("cab")))
+	require.EqualValues(t, 5, tree.CountPrefix("ca"))
+	require.EqualValues(t, 1, tree.CountPrefix("zeb"))
+	require.EqualValues(t, 0, tree.CountPrefix("zebras"))
+
+	var external []string
+	for iter := tree.IteratorPrefix("ze"); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		external = append(external, key)
+	}
+	require.Equal(t, []string{"zebra"}, external)
+
+	var internal []string
+	tree.IteratePrefix("do", func(key string, _ int) bool {
+		internal = append(internal, key)
+		return key != "dog"
+	})
+	require.Equal(t, []string{"do", "dog"}, internal)
+}
+
+func TestRadixTreeLongestPrefixOf(t *testing.T) {
+	tree := createWordRadixTree()
+	prefix, found := tree.LongestPrefixOf("cardboard")
+	require.True(t, found)
+	require.EqualValues(t, "card", prefix)
+
+	prefix, found = tree.LongestPrefixOf("carefully")
+	require.True(t, found)
+	require.EqualValues(t, "careful", prefix)
+
+	prefix, found = tree.LongestPrefixOf("cab")
+	require.True(t, found, "The empty key is a prefix of every string")
+	require.EqualValues(t, "", prefix)
+
+	tree.Delete("")
+	_, found = tree.LongestPrefixOf("cab")
+	require.False(t, found)
+}
+
+func TestRadixTreeDeletePrefix(t *testing.T) {
+	tree := createWordRadixTree()
+	require.EqualValues(t, 4, tree.DeletePrefix("car"))
+	require.EqualValues(t, len(WORDS)-4, tree.Count())
+	require.Equal(t, []string{"cat"}, listToSlice(tree.KeysWithPrefix("c")))
+	require.EqualValues(t, 0, tree.DeletePrefix("car"))
+
+	require.EqualValues(t, 3, tree.DeletePrefix("d"))
+	require.Equal(t, []string{"", "cat", "zebra"}, listToSlice(tree.KeysWithPrefix("")))
+
+	require.EqualValues(t, 3, tree.DeletePrefix(""))
+	require.EqualValues(t, 0, tree.Count())
+	tree.Save("again", 1)
+	require.EqualValues(t, 1, tree.Get("again"))
+}
+
+func TestRadixTreeVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(13))
+	tree := TDATrie.CreateRadixTree[string, int]()
+	reference := map[string]int{}
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("%x", rng.Intn(5000))
+		switch {
+		case i%500 == 0:
+			prefix := key[:1]
+			removed := 0
+			for existing := range reference {
+				if strings.HasPrefix(existing, prefix) {
+					delete(reference, existing)
+					removed++
+				}
+			}
+			require.EqualValues(t, removed, tree.DeletePrefix(prefix))
+		case i%3 == 0:
+			if _, ok := reference[key]; ok {
+				require.EqualValues(t, reference[key], tree.Delete(key))
+				delete(reference, key)
+			}
+		default:
+			tree.Save(key, i)
+			reference[key] = i
+		}
+	}
+
+	require.EqualValues(t, len(reference), tree.Count())
+	withPrefix := 0
+	for key := range reference {
+		if strings.HasPrefix(key, "1a") {
+			withPrefix++
+		}
+	}
+	require.EqualValues(t, withPrefix, tree.CountPrefix("1a"))
+
+	previous, visited := "", 0
+	for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, reference[key], value)
+		require.True(t, visited == 0 || previous < key)
+		previous = key
+		visited++
+	}
+	require.EqualValues(t, len(reference), visited)
+}
+
+func TestRadixTreeJSON(t *testing.T) {
+	tree := createWordRadixTree()
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	copied := TDATrie.CreateRadixTree[string, int]()
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+	for i, word := range WORDS {
+		require.EqualValues(t, i, copied.Get(word))
+	}
+
+	bytesTree := TDATrie.CreateRadixTree[[]byte, int]()
+	bytesTree.Save([]byte{0xff, 0x00}, 1)
+	bytesTree.Save([]byte("ok"), 2)
+	data, err = json.Marshal(bytesTree)
+	require.NoError(t, err)
+	require.Equal(t, `[["b2s=",2],["/wA=",1]]`, string(data), "Byte slice keys are written as base64 in pairs")
+
+	copiedBytes := TDATrie.CreateRadixTree[[]byte, int]()
+	require.NoError(t, json.Unmarshal(data, copiedBytes))
+	require.EqualValues(t, 1, copiedBytes.Get([]byte{0xff, 0x00}))
+	require.EqualValues(t, 2, copiedBytes.Get([]byte("ok")))
+}
+
+func TestRadixTreeFormat(t *testing.T) {
+	tree := TDATrie.CreateRadixTree[string, int]()
+	tree.Save("cat", 2)
+	tree.Save("car", 1)
+	tree.Save("", 0)
+	require.Equal(t, "map{:0 car:1 cat:2}", fmt.Sprint(tree))
+	require.Equal(t, `trie.RadixTree[string, int]{"": 0, "car": 1, "cat": 2}`, fmt.Sprintf("%#v", tree))
+
+	var buffer bytes.Buffer
+	require.NoError(t, tree.(TDADictionary.Dumper).Dump(&buffer))
+	require.Equal(t, "radix tree: 3 keys = 0\n"+
+		"└── \"ca\"\n"+
+		"    ├── \"r\" = 1\n"+
+		"    └── \"t\" = 2\n", buffer.String())
+
+	bytesTree := TDATrie.CreateRadixTree[[]byte, int]()
+	bytesTree.Save([]byte("ok"), 1)
+	require.Equal(t, "map{ok:1}", fmt.Sprint(bytesTree), "Byte slice keys are printed as text")
+}
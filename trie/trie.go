@@ -0,0 +1,59 @@
+package trie
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+)
+
+// Trie is a dictionary of string keys that can also answer questions about their prefixes. Iteration always
+// yields the keys in lexicographic order.
+type Trie[V any] interface {
+	TDADictionary.Dictionary[string, V]
+
+	// KeysWithPrefix returns a list, in lexicographic order, of the keys that start with prefix.
+	KeysWithPrefix(prefix string) TDAList.List[string]
+
+	// LongestPrefixOf returns the longest key that is a prefix of s and true, or "" and false if there is none.
+	LongestPrefixOf(s string) (string, bool)
+
+	// CountPrefix returns the number of keys that start with prefix.
+	CountPrefix(prefix string) int
+
+	// IteratePrefix applies the visit function, in lexicographic order, to the pairs whose keys start with prefix,
+	// until all of them are visited or visit returns false.
+	IteratePrefix(prefix string, visit func(key string, value V) bool)
+
+	// IteratorPrefix returns a DictionaryIterator over the pairs whose keys start with prefix, in lexicographic order.
+	IteratorPrefix(prefix string) TDADictionary.DictionaryIterator[string, V]
+}
+
+// Key is the type of the keys of a RadixTree, which are compared byte by byte.
+type Key interface {
+	~string | ~[]byte
+}
+
+// RadixTree is a compressed trie, where chains of nodes with a single child are merged into one edge. It answers
+// the same prefix questions as a Trie, for string or byte slice keys, and can also delete whole prefixes.
+type RadixTree[K Key, V any] interface {
+	TDADictionary.Dictionary[K, V]
+
+	// KeysWithPrefix returns a list, in lexicographic order, of the keys that start with prefix.
+	KeysWithPrefix(prefix K) TDAList.List[K]
+
+	// LongestPrefixOf returns the longest key that is a prefix of s and true, or an empty key and false if there
+	// is none.
+	LongestPrefixOf(s K) (K, bool)
+
+	// CountPrefix returns the number of keys that start with prefix.
+	CountPrefix(prefix K) int
+
+	// IteratePrefix applies the visit function, in lexicographic order, to the pairs whose keys start with prefix,
+	// until all of them are visited or visit returns false.
+	IteratePrefix(prefix K, visit func(key K, value V) bool)
+
+	// IteratorPrefix returns a DictionaryIterator over the pairs whose keys start with prefix, in lexicographic order.
+	IteratorPrefix(prefix K) TDADictionary.DictionaryIterator[K, V]
+
+	// DeletePrefix removes every key that starts with prefix, returning how many were removed.
+	DeletePrefix(prefix K) int
+}
@@ -0,0 +1,273 @@
+package trie_test
+
+import (
+	TDAList "adts/list"
+	TDATrie "adts/trie"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_MESSAGE_DICTIONARY = "The key does not belong to the dictionary"
+	_PANIC_MESSAGE_ITER       = "The iterator has finished iterating"
+)
+
+var WORDS = []string{"car", "card", "care", "careful", "cat", "do", "dog", "dot", "zebra", ""}
+
+func listToSlice(list TDAList.List[string]) []string {
+	result := []string{}
+	list.Iterate(func(key string) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+func createWordTrie() TDATrie.Trie[int] {
+	trie := TDATrie.CreateTrie[int]()
+	for i, word := range WORDS {
+		trie.Save(word, i)
+	}
+	return trie
+}
+
+func TestEmptyTrie(t *testing.T) {
+	trie := TDATrie.CreateTrie[int]()
+	require.EqualValues(t, 0, trie.Count())
+	require.False(t, trie.Belongs(""))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { trie.Get("a") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { trie.Delete("a") })
+
+	_, found := trie.LongestPrefixOf("abc")
+	require.False(t, found)
+
+	iter := trie.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Current() })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Next() })
+}
+
+func TestTrieDictionaryPrimitives(t *testing.T) {
+	trie := createWordTrie()
+	require.EqualValues(t, len(WORDS), trie.Count())
+	for i, word := range WORDS {
+		require.True(t, trie.Belongs(word))
+		require.EqualValues(t, i, trie.Get(word))
+	}
+	require.False(t, trie.Belongs("ca"), "Prefixes of keys are not keys")
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { trie.Get("ca") })
+
+	trie.Save("car", 100)
+	require.EqualValues(t, 100, trie.Get("car"))
+	require.EqualValues(t, len(WORDS), trie.Count())
+
+	require.EqualValues(t, 100, trie.Delete("car"))
+	require.False(t, trie.Belongs("car"))
+	require.True(t, trie.Belongs("card"), "Deleting a key keeps the keys that extend it")
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { trie.Delete("car") })
+	require.EqualValues(t, len(WORDS)-1, trie.Count())
+
+	require.EqualValues(t, 3, trie.Delete("careful"))
+	require.True(t, trie.Belongs("care"), "Deleting a key keeps its prefixes")
+}
+
+func TestTrieIteratesInLexicographicOrder(t *testing.T) {
+	trie := createWordTrie()
+	expected := append([]string{}, WORDS...)
+	sort.Strings(expected)
+
+	var internal []string
+	trie.Iterate(func(key string, value int) bool {
+		require.EqualValues(t, WORDS[value], key)
+		internal = append(internal, key)
+		return true
+	})
+
+	var external []string
+	for iter := trie.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, WORDS[value], key)
+		external = append(external, key)
+	}
+
+	require.Equal(t, expected, internal)
+	require.Equal(t, expected, external)
+}
+
+func TestTrieKeysWithPrefix(t *testing.T) {
+	trie := createWordTrie()
+	require.Equal(t, []string{"car", "card", "care", "careful"}, listToSlice(trie.KeysWithPrefix("car")))
+	require.Equal(t, []string{"do", "dog", "dot"}, listToSlice(trie.KeysWithPrefix("d")))
+	require.Empty(t, listToSlice(trie.KeysWithPrefix("x")))
+	require.EqualValues(t, len(WORDS), trie.KeysWithPrefix("").Length())
+}
+
+func TestTrieCountPrefix(t *testing.T) {
+	trie := createWordTrie()
+	require.EqualValues(t, 5, trie.CountPrefix("ca"))
+	require.EqualValues(t, 1, trie.CountPrefix("careful"))
+	require.EqualValues(t, 0, trie.CountPrefix("carefully"))
+	require.EqualValues(t, len(WORDS), trie.CountPrefix(""))
+
+	trie.Delete("care")
+	require.EqualValues(t, 4, trie.CountPrefix("ca"))
+	trie.Delete("careful")
+	require.EqualValues(t, 0, trie.CountPrefix("care"))
+}
+
+func TestTrieLongestPrefixOf(t *testing.T) {
+	trie := createWordTrie()
+	prefix, found := trie.LongestPrefixOf("cardboard")
+	require.True(t, found)
+	require.EqualValues(t, "card", prefix)
+
+	prefix, found = trie.LongestPrefixOf("carefully")
+	require.True(t, found)
+	require.EqualValues(t, "careful", prefix)
+
+	prefix, found = trie.LongestPrefixOf("xylophone")
+	require.True(t, found, "The empty key is a prefix of every string")
+	require.EqualValues(t, "", prefix)
+
+	trie.Delete("")
+	_, found = trie.LongestPrefixOf("xylophone")
+	require.False(t, found)
+}
+
+func TestTriePrefixIterators(t *testing.T) {
+	trie := createWordTrie()
+
+	var internal []string
+	trie.IteratePrefix("do", func(key string, _ int) bool {
+		internal = append(internal, key)
+		return key != "dog"
+	})
+	require.Equal(t, []string{"do", "dog"}, internal)
+
+	var external []string
+	for iter := trie.IteratorPrefix("ca"); iter.HasNext(); iter.Next() {
+		key, _ := iter.Current()
+		external = append(external, key)
+	}
+	require.Equal(t, []string{"car", "card", "care", "careful", "cat"}, external)
+
+	require.False(t, trie.IteratorPrefix("cab").HasNext())
+}
+
+func TestTrieNonASCIIKeys(t *testing.T) {
+	trie := TDATrie.CreateTrie[int]()
+	keys := []string{"ñandú", "ñu", "árbol", "arbol", "\xff\x00"}
+	for i, key := range keys {
+		trie.Save(key, i)
+	}
+
+	var external []string
+	for iter := trie.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, keys[value], key)
+		external = append(external, key)
+	}
+	require.True(t, sort.StringsAreSorted(external))
+	require.Equal(t, []string{"ñandú", "ñu"}, listToSlice(trie.KeysWithPrefix("ñ")))
+}
+
+func TestTrieVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	trie := TDATrie.CreateTrie[int]()
+	reference := map[string]int{}
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("%x", rng.Intn(5000))
+		if _, ok := reference[key]; ok && i%3 == 0 {
+			require.EqualValues(t, reference[key], trie.Delete(key))
+			delete(reference, key)
+			continue
+		}
+		trie.Save(key, i)
+		reference[key] = i
+	}
+
+	require.EqualValues(t, len(reference), trie.Count())
+	withPrefix := 0
+	for key := range reference {
+		if strings.HasPrefix(key, "1a") {
+			withPrefix++
+		}
+	}
+	require.EqualValues(t, withPrefix, trie.CountPrefix("1a"))
+	require.EqualValues(t, withPrefix, trie.KeysWithPrefix("1a").Length())
+
+	previous := ""
+	trie.Iterate(func(key string, value int) bool {
+		require.EqualValues(t, reference[key], value)
+		require.True(t, previous == "" || previous < key)
+		previous = key
+		return true
+	})
+}
+
+func TestTrieJSON(t *testing.T) {
+	trie := createWordTrie()
+	data, err := json.Marshal(trie)
+	require.NoError(t, err)
+	require.Equal(t, `{"":9,"car":0,"card":1,"care":2,"careful":3,"cat":4,"do":5,"dog":6,"dot":7,"zebra":8}`, string(data))
+
+	copied := TDATrie.CreateTrie[int]()
+	copied.Save("cart", 10)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+	require.False(t, copied.Belongs("cart"))
+	require.Equal(t, []string{"car", "card", "care", "careful", "cat"}, listToSlice(copied.KeysWithPrefix("ca")))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[["car",1]]`), copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+}
+
+func TestTrieBinary(t *testing.T) {
+	trie := createWordTrie()
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(trie))
+	data := buffer.Bytes()
+
+	copied := TDATrie.CreateTrie[int]()
+	copied.Save("cart", 10)
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+	require.False(t, copied.Belongs("cart"))
+	require.Equal(t, []string{"car", "card", "care", "careful", "cat"}, listToSlice(copied.KeysWithPrefix("ca")))
+
+	tree := TDATrie.CreateRadixTree[string, int]()
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(tree), "The trie and the radix tree read each other")
+	for i, word := range WORDS {
+		require.EqualValues(t, i, tree.Get(word))
+	}
+}
+
+func TestTrieFormat(t *testing.T) {
+	trie := TDATrie.CreateTrie[int]()
+	require.Equal(t, "map{}", fmt.Sprint(trie))
+	trie.Save("cat", 2)
+	trie.Save("car", 1)
+	trie.Save("do", 3)
+	require.Equal(t, "map{car:1 cat:2 do:3}", fmt.Sprint(trie))
+	require.Equal(t, `trie.Trie[int]{"car": 1, "cat": 2, "do": 3}`, fmt.Sprintf("%#v", trie))
+
+	var buffer bytes.Buffer
+	require.NoError(t, trie.(interface{ Dump(io.Writer) error }).Dump(&buffer))
+	require.Equal(t, "trie: 3 keys\n"+
+		"├── 'c'\n"+
+		"│   └── 'a'\n"+
+		"│       ├── 'r' = 1\n"+
+		"│       └── 't' = 2\n"+
+		"└── 'd'\n"+
+		"    └── 'o' = 3\n", buffer.String())
+}
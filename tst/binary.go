@@ -0,0 +1,42 @@
+package tst
+
+import (
+	TDADictionary "adts/dictionary"
+	"adts/snapshot"
+	"io"
+)
+
+// -------------------- BINARY PRIMITIVES --------------------
+
+// WriteTo writes a binary snapshot of the pairs of the tree, as TDADictionary.WriteDictionary does, with the keys
+// in lexicographic order.
+func (tree *ternarySearchTree[V]) WriteTo(w io.Writer) (int64, error) {
+	return TDADictionary.WriteDictionary[string, V](w, tree)
+}
+
+// ReadFrom replaces the pairs of the tree with those of a snapshot written by any dictionary, or returns an error
+// without changing them.
+func (tree *ternarySearchTree[V]) ReadFrom(r io.Reader) (int64, error) {
+	read := CreateTernarySearchTree[V]().(*ternarySearchTree[V])
+	n, err := TDADictionary.ReadDictionary[string, V](r, read)
+	if err == nil {
+		*tree = *read
+	}
+	return n, err
+}
+
+func (tree *ternarySearchTree[V]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *ternarySearchTree[V]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
+
+func (tree *ternarySearchTree[V]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(tree)
+}
+
+func (tree *ternarySearchTree[V]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, tree)
+}
@@ -0,0 +1,49 @@
+package tst
+
+import (
+	TDADictionary "adts/dictionary"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// -------------------- FORMAT PRIMITIVES --------------------
+
+// String formats the tree like map{car:1 cat:2}, with its keys in lexicographic order.
+func (tree *ternarySearchTree[V]) String() string {
+	return TDADictionary.FormatDictionary[string, V](tree)
+}
+
+func (tree *ternarySearchTree[V]) GoString() string {
+	return TDADictionary.GoFormatDictionary[string, V](fmt.Sprintf("tst.TernarySearchTree[%v]", reflect.TypeFor[V]()), tree)
+}
+
+// Dump writes a node per byte stored in the tree, with the value of the nodes that end a key. The children of a
+// node are listed as lo, eq and hi, with nil standing for a missing one.
+func (tree *ternarySearchTree[V]) Dump(w io.Writer) error {
+	header := fmt.Sprintf("tst: %d keys", tree.count)
+	if tree.hasEmptyValue {
+		header += fmt.Sprintf(", \"\" = %v", tree.emptyValue)
+	}
+	if _, err := fmt.Fprintln(w, header); err != nil {
+		return err
+	}
+
+	children := func(node *tstNode[V]) []*tstNode[V] {
+		if node == nil || (node.lo == nil && node.eq == nil && node.hi == nil) {
+			return nil
+		}
+		return []*tstNode[V]{node.lo, node.eq, node.hi}
+	}
+	label := func(node *tstNode[V]) string {
+		if node == nil {
+			return "nil"
+		}
+		text := fmt.Sprintf("%q", node.char)
+		if node.hasValue {
+			text += fmt.Sprintf(" = %v", node.value)
+		}
+		return text
+	}
+	return TDADictionary.PrintTree(w, tree.root, children, label)
+}
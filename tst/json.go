@@ -0,0 +1,16 @@
+package tst
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+// -------------------- JSON PRIMITIVES --------------------
+
+// MarshalJSON encodes the tree as a JSON object with its keys in lexicographic order.
+func (tree *ternarySearchTree[V]) MarshalJSON() ([]byte, error) {
+	return TDADictionary.MarshalDictionary[string, V](tree)
+}
+
+func (tree *ternarySearchTree[V]) UnmarshalJSON(data []byte) error {
+	return TDADictionary.UnmarshalDictionary[string, V](data, tree)
+}
@@ -0,0 +1,367 @@
+package tst
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+	TDAStack "adts/stack"
+)
+
+const (
+	_PANIC_MESSAGE_DICTIONARY = "The key does not belong to the dictionary"
+	_PANIC_MESSAGE_ITER       = "The iterator has finished iterating"
+	_PANIC_MESSAGE_DISTANCE   = "The distance cannot be negative"
+	_WILDCARD                 = '?'
+)
+
+// tstNode holds one byte of the keys. lo and hi lead to the nodes with smaller and greater bytes in the same
+// position, while eq leads to the next position of the keys that have this byte here.
+type tstNode[V any] struct {
+	char     byte
+	lo       *tstNode[V]
+	eq       *tstNode[V]
+	hi       *tstNode[V]
+	value    V
+	hasValue bool
+}
+
+// ternarySearchTree keeps the value of the empty key apart, since every node stands for a byte of its key.
+type ternarySearchTree[V any] struct {
+	root          *tstNode[V]
+	emptyValue    V
+	hasEmptyValue bool
+	count         int
+}
+
+// pendingFrame is either a subtree still to be expanded, where key is the string spelled before reaching it, or a
+// pair ready to be returned by the iterator.
+type pendingFrame[V any] struct {
+	node  *tstNode[V]
+	key   string
+	value V
+	ready bool
+}
+
+type iterTernarySearchTree[V any] struct {
+	stack TDAStack.Stack[pendingFrame[V]]
+}
+
+// CreateTernarySearchTree creates an empty TernarySearchTree.
+func CreateTernarySearchTree[V any]() TernarySearchTree[V] {
+	return &ternarySearchTree[V]{}
+}
+
+// -------------------- DICTIONARY PRIMITIVES --------------------
+
+func (tree *ternarySearchTree[V]) Save(key string, value V) {
+	if key == "" {
+		if !tree.hasEmptyValue {
+			tree.count++
+		}
+		tree.emptyValue, tree.hasEmptyValue = value, true
+		return
+	}
+
+	link := &tree.root
+	for i := 0; ; {
+		if *link == nil {
+			*link = &tstNode[V]{char: key[i]}
+		}
+		node := *link
+		switch {
+		case key[i] < node.char:
+			link = &node.lo
+		case key[i] > node.char:
+			link = &node.hi
+		case i < len(key)-1:
+			link = &node.eq
+			i++
+		default:
+			if !node.hasValue {
+				tree.count++
+			}
+			node.value, node.hasValue = value, true
+			return
+		}
+	}
+}
+
+func (tree *ternarySearchTree[V]) Belongs(key string) bool {
+	if key == "" {
+		return tree.hasEmptyValue
+	}
+	node := tree.find(key)
+	return node != nil && node.hasValue
+}
+
+func (tree *ternarySearchTree[V]) Get(key string) V {
+	if key == "" {
+		if !tree.hasEmptyValue {
+			panic(_PANIC_MESSAGE_DICTIONARY)
+		}
+		return tree.emptyValue
+	}
+
+	node := tree.find(key)
+	if node == nil || !node.hasValue {
+		panic(_PANIC_MESSAGE_DICTIONARY)
+	}
+	return node.value
+}
+
+func (tree *ternarySearchTree[V]) Delete(key string) V {
+	value := tree.Get(key)
+	var zero V
+	if key == "" {
+		tree.emptyValue, tree.hasEmptyValue = zero, false
+	} else {
+		tree.root = deleteKey(tree.root, key, 0)
+	}
+	tree.count--
+	return value
+}
+
+func (tree *ternarySearchTree[V]) Count() int {
+	return tree.count
+}
+
+func (tree *ternarySearchTree[V]) Iterate(visit func(key string, value V) bool) {
+	if tree.hasEmptyValue && !visit("", tree.emptyValue) {
+		return
+	}
+	tree.root.iterate(nil, visit)
+}
+
+func (tree *ternarySearchTree[V]) Iterator() TDADictionary.DictionaryIterator[string, V] {
+	iter := &iterTernarySearchTree[V]{stack: TDAStack.NewDynamicStack[pendingFrame[V]]()}
+	if tree.root != nil {
+		iter.stack.Push(pendingFrame[V]{node: tree.root})
+	}
+	if tree.hasEmptyValue {
+		iter.stack.Push(pendingFrame[V]{value: tree.emptyValue, ready: true})
+	}
+	iter.advanceToReady()
+	return iter
+}
+
+// ------------------------ SEARCH PRIMITIVES ------------------------
+
+func (tree *ternarySearchTree[V]) Match(pattern string) TDAList.List[string] {
+	keys := TDAList.CreateLinkedList[string]()
+	if pattern == "" {
+		if tree.hasEmptyValue {
+			keys.InsertLast("")
+		}
+		return keys
+	}
+	tree.root.match(pattern, nil, keys)
+	return keys
+}
+
+func (tree *ternarySearchTree[V]) Neighbors(key string, distance int) TDAList.List[string] {
+	if distance < 0 {
+		panic(_PANIC_MESSAGE_DISTANCE)
+	}
+
+	keys := TDAList.CreateLinkedList[string]()
+	if key == "" {
+		if tree.hasEmptyValue {
+			keys.InsertLast("")
+		}
+		return keys
+	}
+	tree.root.neighbors(key, distance, nil, keys)
+	return keys
+}
+
+// ----------------- EXTERNAL ITERATOR PRIMITIVES -----------------
+
+func (iter *iterTernarySearchTree[V]) HasNext() bool {
+	return !iter.stack.IsEmpty()
+}
+
+func (iter *iterTernarySearchTree[V]) Current() (string, V) {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	top := iter.stack.Top()
+	return top.key, top.value
+}
+
+func (iter *iterTernarySearchTree[V]) Next() {
+	if !iter.HasNext() {
+		panic(_PANIC_MESSAGE_ITER)
+	}
+	iter.stack.Pop()
+	iter.advanceToReady()
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// Tree functions
+
+// find returns the node of the last byte of a non empty key, or nil if there is none.
+func (tree *ternarySearchTree[V]) find(key string) *tstNode[V] {
+	current := tree.root
+	for i := 0; current != nil; {
+		switch {
+		case key[i] < current.char:
+			current = current.lo
+		case key[i] > current.char:
+			current = current.hi
+		case i < len(key)-1:
+			current = current.eq
+			i++
+		default:
+			return current
+		}
+	}
+	return nil
+}
+
+// deleteKey removes the value of key, which must belong to the subtree, from position i on. It returns the new
+// root of the subtree, after removing the nodes left without keys.
+func deleteKey[V any](node *tstNode[V], key string, i int) *tstNode[V] {
+	switch {
+	case key[i] < node.char:
+		node.lo = deleteKey(node.lo, key, i)
+	case key[i] > node.char:
+		node.hi = deleteKey(node.hi, key, i)
+	case i < len(key)-1:
+		node.eq = deleteKey(node.eq, key, i+1)
+	default:
+		var zero V
+		node.value, node.hasValue = zero, false
+	}
+
+	if node.hasValue || node.eq != nil {
+		return node
+	}
+	return removeNode(node)
+}
+
+// removeNode takes the node out of the binary search tree formed by the lo and hi links of its position, as a
+// binary search tree deletion would, returning the node that takes its place.
+func removeNode[V any](node *tstNode[V]) *tstNode[V] {
+	if node.lo == nil {
+		return node.hi
+	}
+	if node.hi == nil {
+		return node.lo
+	}
+
+	successor := node.hi
+	for successor.lo != nil {
+		successor = successor.lo
+	}
+	successor.hi = removeMin(node.hi)
+	successor.lo = node.lo
+	return successor
+}
+
+func removeMin[V any](node *tstNode[V]) *tstNode[V] {
+	if node.lo == nil {
+		return node.hi
+	}
+	node.lo = removeMin(node.lo)
+	return node
+}
+
+// Node functions
+
+// iterate visits the subtree in lexicographic order, prefix being the bytes spelled before reaching node. It
+// returns false once visit asks to stop.
+func (node *tstNode[V]) iterate(prefix []byte, visit func(string, V) bool) bool {
+	if node == nil {
+		return true
+	}
+	if !node.lo.iterate(prefix, visit) {
+		return false
+	}
+	key := append(prefix, node.char)
+	if node.hasValue && !visit(string(key), node.value) {
+		return false
+	}
+	if !node.eq.iterate(key, visit) {
+		return false
+	}
+	return node.hi.iterate(prefix, visit)
+}
+
+func (node *tstNode[V]) match(pattern string, prefix []byte, keys TDAList.List[string]) {
+	if node == nil {
+		return
+	}
+
+	char := pattern[len(prefix)]
+	if char == _WILDCARD || char < node.char {
+		node.lo.match(pattern, prefix, keys)
+	}
+	if char == _WILDCARD || char == node.char {
+		key := append(prefix, node.char)
+		if len(key) == len(pattern) {
+			if node.hasValue {
+				keys.InsertLast(string(key))
+			}
+		} else {
+			node.eq.match(pattern, key, keys)
+		}
+	}
+	if char == _WILDCARD || char > node.char {
+		node.hi.match(pattern, prefix, keys)
+	}
+}
+
+// neighbors adds the keys of the subtree that differ from key in at most distance of the bytes still to compare.
+func (node *tstNode[V]) neighbors(key string, distance int, prefix []byte, keys TDAList.List[string]) {
+	if node == nil {
+		return
+	}
+
+	char := key[len(prefix)]
+	if distance > 0 || char < node.char {
+		node.lo.neighbors(key, distance, prefix, keys)
+	}
+
+	remaining := distance
+	if char != node.char {
+		remaining--
+	}
+	if remaining >= 0 {
+		next := append(prefix, node.char)
+		if len(next) == len(key) {
+			if node.hasValue {
+				keys.InsertLast(string(next))
+			}
+		} else {
+			node.eq.neighbors(key, remaining, next, keys)
+		}
+	}
+
+	if distance > 0 || char > node.char {
+		node.hi.neighbors(key, distance, prefix, keys)
+	}
+}
+
+// External iterator functions
+
+// advanceToReady expands the subtrees on top of the stack until a pair ready to be returned is on top. The parts
+// of a subtree are pushed so that they come out in order: smaller bytes, the node, its continuations, greater bytes.
+func (iter *iterTernarySearchTree[V]) advanceToReady() {
+	for !iter.stack.IsEmpty() && !iter.stack.Top().ready {
+		frame := iter.stack.Pop()
+		node := frame.node
+		key := frame.key + string([]byte{node.char})
+		if node.hi != nil {
+			iter.stack.Push(pendingFrame[V]{node: node.hi, key: frame.key})
+		}
+		if node.eq != nil {
+			iter.stack.Push(pendingFrame[V]{node: node.eq, key: key})
+		}
+		if node.hasValue {
+			iter.stack.Push(pendingFrame[V]{key: key, value: node.value, ready: true})
+		}
+		if node.lo != nil {
+			iter.stack.Push(pendingFrame[V]{node: node.lo, key: frame.key})
+		}
+	}
+}
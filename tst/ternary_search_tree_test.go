@@ -0,0 +1,239 @@
+package tst_test
+
+import (
+	TDAList "adts/list"
+	TDATST "adts/tst"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	_PANIC_MESSAGE_DICTIONARY = "The key does not belong to the dictionary"
+	_PANIC_MESSAGE_ITER       = "The iterator has finished iterating"
+)
+
+var WORDS = []string{"cat", "cut", "cot", "coat", "car", "cart", "bat", "bit", "dog", "c", ""}
+
+func listToSlice(list TDAList.List[string]) []string {
+	result := []string{}
+	list.Iterate(func(key string) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+func createWordTree() TDATST.TernarySearchTree[int] {
+	tree := TDATST.CreateTernarySearchTree[int]()
+	for i, word := range WORDS {
+		tree.Save(word, i)
+	}
+	return tree
+}
+
+func hamming(a, b string) int {
+	distance := 0
+	for i := range a {
+		if a[i] != b[i] {
+			distance++
+		}
+	}
+	return distance
+}
+
+func TestEmptyTernarySearchTree(t *testing.T) {
+	tree := TDATST.CreateTernarySearchTree[int]()
+	require.EqualValues(t, 0, tree.Count())
+	require.False(t, tree.Belongs(""))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Get("a") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Delete("") })
+	require.Empty(t, listToSlice(tree.Match("???")))
+	require.Empty(t, listToSlice(tree.Neighbors("abc", 3)))
+
+	iter := tree.Iterator()
+	require.False(t, iter.HasNext())
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Current() })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_ITER, func() { iter.Next() })
+}
+
+func TestTernarySearchTreeDictionaryPrimitives(t *testing.T) {
+	tree := createWordTree()
+	require.EqualValues(t, len(WORDS), tree.Count())
+	for i, word := range WORDS {
+		require.True(t, tree.Belongs(word))
+		require.EqualValues(t, i, tree.Get(word))
+	}
+	require.False(t, tree.Belongs("ca"), "Prefixes of keys are not keys")
+	require.False(t, tree.Belongs("cats"))
+
+	tree.Save("cat", 100)
+	require.EqualValues(t, 100, tree.Get("cat"))
+	require.EqualValues(t, len(WORDS), tree.Count())
+
+	require.EqualValues(t, 100, tree.Delete("cat"))
+	require.False(t, tree.Belongs("cat"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_DICTIONARY, func() { tree.Delete("cat") })
+	require.EqualValues(t, 4, tree.Delete("car"))
+	require.True(t, tree.Belongs("cart"), "Deleting a key keeps the keys that extend it")
+	require.EqualValues(t, 9, tree.Delete("c"))
+	require.True(t, tree.Belongs("cut"), "Deleting a key keeps the keys that extend it")
+	require.EqualValues(t, 10, tree.Delete(""))
+	require.EqualValues(t, len(WORDS)-4, tree.Count())
+}
+
+func TestTernarySearchTreeIteratesInLexicographicOrder(t *testing.T) {
+	tree := createWordTree()
+	expected := append([]string{}, WORDS...)
+	sort.Strings(expected)
+
+	var internal []string
+	tree.Iterate(func(key string, value int) bool {
+		require.EqualValues(t, WORDS[value], key)
+		internal = append(internal, key)
+		return true
+	})
+
+	var external []string
+	for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, WORDS[value], key)
+		external = append(external, key)
+	}
+
+	require.Equal(t, expected, internal)
+	require.Equal(t, expected, external)
+
+	visited := 0
+	tree.Iterate(func(string, int) bool {
+		visited++
+		return visited < 3
+	})
+	require.EqualValues(t, 3, visited)
+}
+
+func TestTernarySearchTreeMatch(t *testing.T) {
+	tree := createWordTree()
+	require.Equal(t, []string{"cat", "cot", "cut"}, listToSlice(tree.Match("c?t")))
+	require.Equal(t, []string{"bat", "bit", "cat", "cot", "cut"}, listToSlice(tree.Match("??t")))
+	require.Equal(t, []string{"cart", "coat"}, listToSlice(tree.Match("c??t")))
+	require.Equal(t, []string{"dog"}, listToSlice(tree.Match("dog")))
+	require.Equal(t, []string{"c"}, listToSlice(tree.Match("?")))
+	require.Equal(t, []string{""}, listToSlice(tree.Match("")))
+	require.Empty(t, listToSlice(tree.Match("d?t")))
+}
+
+func TestTernarySearchTreeNeighbors(t *testing.T) {
+	tree := createWordTree()
+	require.Equal(t, []string{"cat"}, listToSlice(tree.Neighbors("cat", 0)))
+	require.Equal(t, []string{"bat", "car", "cat", "cot", "cut"}, listToSlice(tree.Neighbors("cat", 1)))
+	require.Equal(t, []string{"bat", "bit", "car", "cat", "cot", "cut"}, listToSlice(tree.Neighbors("cit", 2)))
+	require.Equal(t, []string{"cart", "coat"}, listToSlice(tree.Neighbors("cost", 2)),
+		"Only keys with the same length are neighbors")
+	require.Empty(t, listToSlice(tree.Neighbors("xyz", 2)))
+	require.PanicsWithValue(t, "The distance cannot be negative", func() { tree.Neighbors("cat", -1) })
+}
+
+func TestTernarySearchTreeVolume(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+	tree := TDATST.CreateTernarySearchTree[int]()
+	reference := map[string]int{}
+	for i := 0; i < 20000; i++ {
+		key := fmt.Sprintf("%03x", rng.Intn(4096))
+		if _, ok := reference[key]; ok && i%3 == 0 {
+			require.EqualValues(t, reference[key], tree.Delete(key))
+			delete(reference, key)
+			continue
+		}
+		tree.Save(key, i)
+		reference[key] = i
+	}
+	require.EqualValues(t, len(reference), tree.Count())
+
+	var matching, near []string
+	for key := range reference {
+		if key[1] == 'a' {
+			matching = append(matching, key)
+		}
+		if hamming(key, "5a5") <= 1 {
+			near = append(near, key)
+		}
+	}
+	sort.Strings(matching)
+	sort.Strings(near)
+	require.Equal(t, matching, listToSlice(tree.Match("?a?")))
+	require.Equal(t, near, listToSlice(tree.Neighbors("5a5", 1)))
+
+	previous, visited := "", 0
+	for iter := tree.Iterator(); iter.HasNext(); iter.Next() {
+		key, value := iter.Current()
+		require.EqualValues(t, reference[key], value)
+		require.True(t, visited == 0 || previous < key)
+		previous = key
+		visited++
+	}
+	require.EqualValues(t, len(reference), visited)
+}
+
+func TestTernarySearchTreeJSON(t *testing.T) {
+	tree := createWordTree()
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	require.Equal(t, `{"":10,"bat":6,"bit":7,"c":9,"car":4,"cart":5,"cat":0,"coat":3,"cot":2,"cut":1,"dog":8}`, string(data))
+
+	copied := TDATST.CreateTernarySearchTree[int]()
+	copied.Save("cab", 11)
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+	require.False(t, copied.Belongs("cab"))
+	require.EqualValues(t, 10, copied.Get(""))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), copied))
+	require.Error(t, json.Unmarshal([]byte(`{"cat":"zero"}`), copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+}
+
+func TestTernarySearchTreeBinary(t *testing.T) {
+	tree := createWordTree()
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(tree))
+
+	copied := TDATST.CreateTernarySearchTree[int]()
+	copied.Save("cab", 11)
+	require.NoError(t, gob.NewDecoder(&buffer).Decode(copied))
+	require.EqualValues(t, len(WORDS), copied.Count())
+	require.False(t, copied.Belongs("cab"))
+	require.EqualValues(t, 10, copied.Get(""))
+	require.Equal(t, []string{"car", "cat"}, listToSlice(copied.Match("ca?")))
+}
+
+func TestTernarySearchTreeFormat(t *testing.T) {
+	tree := TDATST.CreateTernarySearchTree[int]()
+	tree.Save("cat", 2)
+	tree.Save("car", 1)
+	tree.Save("b", 3)
+	require.Equal(t, "map{b:3 car:1 cat:2}", fmt.Sprint(tree))
+	require.Equal(t, `tst.TernarySearchTree[int]{"b": 3, "car": 1, "cat": 2}`, fmt.Sprintf("%#v", tree))
+
+	tree.Save("", 0)
+	var buffer bytes.Buffer
+	require.NoError(t, tree.(interface{ Dump(io.Writer) error }).Dump(&buffer))
+	require.Equal(t, "tst: 4 keys, \"\" = 0\n"+
+		"'c'\n"+
+		"├── 'b' = 3\n"+
+		"├── 'a'\n"+
+		"│   ├── nil\n"+
+		"│   ├── 't' = 2\n"+
+		"│   │   ├── 'r' = 1\n"+
+		"│   │   ├── nil\n"+
+		"│   │   └── nil\n"+
+		"│   └── nil\n"+
+		"└── nil\n", buffer.String())
+}
@@ -0,0 +1,20 @@
+package tst
+
+import (
+	TDADictionary "adts/dictionary"
+	TDAList "adts/list"
+)
+
+// TernarySearchTree is a dictionary of string keys that can also find the keys that look like a given one, as a
+// spell checker would. Iteration and every query yield the keys in lexicographic order.
+type TernarySearchTree[V any] interface {
+	TDADictionary.Dictionary[string, V]
+
+	// Match returns a list of the keys that match pattern, where the wildcard '?' stands for exactly one byte and
+	// every other byte stands for itself.
+	Match(pattern string) TDAList.List[string]
+
+	// Neighbors returns a list of the keys with the same length as key that differ from it in at most distance
+	// bytes, that is, whose Hamming distance to key is at most distance. It panics if distance is negative.
+	Neighbors(key string, distance int) TDAList.List[string]
+}
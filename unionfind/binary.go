@@ -0,0 +1,68 @@
+package unionfind
+
+import (
+	"adts/snapshot"
+	"io"
+)
+
+const _SNAPSHOT_KIND = "unionfind"
+
+// -------------------- BINARY PRIMITIVES --------------------
+
+// WriteTo writes a binary snapshot of the sets of the structure, each one as the slice of its elements encoded with
+// encoding/gob, with the sets and their elements in the order they were added.
+func (forest *disjointSetForest[T]) WriteTo(w io.Writer) (int64, error) {
+	writer, err := snapshot.NewWriter(w, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	sets := forest.partition()
+	err = snapshot.Write(writer, len(sets), func(visit func([]T) bool) {
+		for _, set := range sets {
+			if !visit(set) {
+				return
+			}
+		}
+	})
+	return writer.BytesWritten(), err
+}
+
+// ReadFrom replaces the sets of the structure with those of a snapshot written by WriteTo, keeping its cmp, and
+// reads nothing past the end of the snapshot. If the snapshot cannot be read, holds an empty set or repeats an
+// element, it returns an error without changing the structure.
+func (forest *disjointSetForest[T]) ReadFrom(r io.Reader) (int64, error) {
+	reader, err := snapshot.NewReader(r, _SNAPSHOT_KIND)
+	if err != nil {
+		return 0, err
+	}
+	read := CreateUnionFind(forest.cmp).(*disjointSetForest[T])
+	var addErr error
+	err = snapshot.Read(reader, func(set []T) {
+		if addErr == nil {
+			addErr = read.addSet(set)
+		}
+	})
+	if err == nil {
+		err = addErr
+	}
+	if err == nil {
+		*forest = *read
+	}
+	return reader.BytesRead(), err
+}
+
+func (forest *disjointSetForest[T]) MarshalBinary() ([]byte, error) {
+	return snapshot.Marshal(forest)
+}
+
+func (forest *disjointSetForest[T]) UnmarshalBinary(data []byte) error {
+	return snapshot.Unmarshal(data, forest)
+}
+
+func (forest *disjointSetForest[T]) GobEncode() ([]byte, error) {
+	return snapshot.Marshal(forest)
+}
+
+func (forest *disjointSetForest[T]) GobDecode(data []byte) error {
+	return snapshot.Unmarshal(data, forest)
+}
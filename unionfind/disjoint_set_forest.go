@@ -0,0 +1,105 @@
+package unionfind
+
+import (
+	TDADictionary "adts/dictionary"
+)
+
+const _PANIC_MESSAGE_MISSING = "The element does not belong to the structure"
+
+// disjointSetForest numbers the elements in the order they are added, through a dictionary, and keeps every set as
+// a tree of those numbers. parents[i] is the parent of i, or i itself if it is the root, which is the
+// representative of the set. The rank of a root bounds the height of its tree, and size counts its elements.
+type disjointSetForest[T any] struct {
+	indices  TDADictionary.Dictionary[T, int]
+	cmp      func(T, T) bool
+	elements []T
+	parents  []int
+	ranks    []int
+	sizes    []int
+	sets     int
+}
+
+// CreateUnionFind creates an empty UnionFind that finds its elements in a hash dictionary, using cmp to tell
+// whether two elements are equal. Find and Union compress the paths they walk and hang the tree of lower rank
+// from the other one, so any sequence of operations takes almost constant amortized time each.
+func CreateUnionFind[T any](cmp func(T, T) bool) UnionFind[T] {
+	return &disjointSetForest[T]{indices: TDADictionary.CreateHash[T, int](cmp), cmp: cmp}
+}
+
+// -------------------- UNION FIND PRIMITIVES --------------------
+
+func (forest *disjointSetForest[T]) Add(element T) {
+	if forest.indices.Belongs(element) {
+		return
+	}
+	index := len(forest.elements)
+	forest.indices.Save(element, index)
+	forest.elements = append(forest.elements, element)
+	forest.parents = append(forest.parents, index)
+	forest.ranks = append(forest.ranks, 0)
+	forest.sizes = append(forest.sizes, 1)
+	forest.sets++
+}
+
+func (forest *disjointSetForest[T]) Contains(element T) bool {
+	return forest.indices.Belongs(element)
+}
+
+func (forest *disjointSetForest[T]) Find(element T) T {
+	return forest.elements[forest.root(forest.index(element))]
+}
+
+func (forest *disjointSetForest[T]) Union(a T, b T) bool {
+	rootA, rootB := forest.root(forest.index(a)), forest.root(forest.index(b))
+	if rootA == rootB {
+		return false
+	}
+
+	if forest.ranks[rootA] < forest.ranks[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	forest.parents[rootB] = rootA
+	forest.sizes[rootA] += forest.sizes[rootB]
+	if forest.ranks[rootA] == forest.ranks[rootB] {
+		forest.ranks[rootA]++
+	}
+	forest.sets--
+	return true
+}
+
+func (forest *disjointSetForest[T]) Connected(a T, b T) bool {
+	return forest.root(forest.index(a)) == forest.root(forest.index(b))
+}
+
+func (forest *disjointSetForest[T]) SetSize(element T) int {
+	return forest.sizes[forest.root(forest.index(element))]
+}
+
+func (forest *disjointSetForest[T]) Count() int {
+	return len(forest.elements)
+}
+
+func (forest *disjointSetForest[T]) Sets() int {
+	return forest.sets
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+func (forest *disjointSetForest[T]) index(element T) int {
+	if !forest.indices.Belongs(element) {
+		panic(_PANIC_MESSAGE_MISSING)
+	}
+	return forest.indices.Get(element)
+}
+
+// root returns the root of the tree of i, and then makes it the parent of every index on the way to it
+func (forest *disjointSetForest[T]) root(i int) int {
+	root := i
+	for forest.parents[root] != root {
+		root = forest.parents[root]
+	}
+	for forest.parents[i] != root {
+		forest.parents[i], i = root, forest.parents[i]
+	}
+	return root
+}
@@ -0,0 +1,190 @@
+package unionfind_test
+
+import (
+	TDAUnionFind "adts/unionfind"
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const _PANIC_MESSAGE_MISSING = "The element does not belong to the structure"
+
+func createUnionFind(elements ...string) TDAUnionFind.UnionFind[string] {
+	unionFind := TDAUnionFind.CreateUnionFind[string](func(a, b string) bool { return a == b })
+	for _, element := range elements {
+		unionFind.Add(element)
+	}
+	return unionFind
+}
+
+func TestEmptyUnionFind(t *testing.T) {
+	unionFind := createUnionFind()
+	require.EqualValues(t, 0, unionFind.Count())
+	require.EqualValues(t, 0, unionFind.Sets())
+	require.False(t, unionFind.Contains("A"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { unionFind.Find("A") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { unionFind.Union("A", "B") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { unionFind.Connected("A", "B") })
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { unionFind.SetSize("A") })
+}
+
+func TestUnionFindSingletons(t *testing.T) {
+	unionFind := createUnionFind("A", "B", "C")
+	unionFind.Add("A")
+	require.EqualValues(t, 3, unionFind.Count(), "Adding an element twice changes nothing")
+	require.EqualValues(t, 3, unionFind.Sets())
+	require.True(t, unionFind.Contains("B"))
+	for _, element := range []string{"A", "B", "C"} {
+		require.EqualValues(t, element, unionFind.Find(element))
+		require.EqualValues(t, 1, unionFind.SetSize(element))
+	}
+	require.False(t, unionFind.Connected("A", "B"))
+	require.PanicsWithValue(t, _PANIC_MESSAGE_MISSING, func() { unionFind.Union("A", "Z") })
+}
+
+func TestUnionFindUnion(t *testing.T) {
+	unionFind := createUnionFind("A", "B", "C", "D", "E")
+	require.True(t, unionFind.Union("A", "B"))
+	require.True(t, unionFind.Union("C", "D"))
+	require.False(t, unionFind.Union("B", "A"), "A and B already are in the same set")
+	require.EqualValues(t, 3, unionFind.Sets())
+	require.True(t, unionFind.Connected("A", "B"))
+	require.False(t, unionFind.Connected("B", "C"))
+	require.EqualValues(t, unionFind.Find("A"), unionFind.Find("B"))
+
+	require.True(t, unionFind.Union("B", "D"))
+	require.EqualValues(t, 2, unionFind.Sets())
+	require.EqualValues(t, 4, unionFind.SetSize("C"))
+	require.True(t, unionFind.Connected("A", "C"))
+	require.Contains(t, []string{"A", "B", "C", "D"}, unionFind.Find("D"))
+	require.EqualValues(t, "E", unionFind.Find("E"))
+	require.EqualValues(t, 1, unionFind.SetSize("E"))
+}
+
+func TestUnionFindAgainstLabels(t *testing.T) {
+	rng := rand.New(rand.NewSource(44))
+	unionFind := TDAUnionFind.CreateUnionFind[int](func(a, b int) bool { return a == b })
+	// labels gives every element the smallest element of its set, relabelling a whole set on each union
+	labels := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		a, b := rng.Intn(3000), rng.Intn(3000)
+		for _, element := range []int{a, b} {
+			if _, ok := labels[element]; !ok {
+				unionFind.Add(element)
+				labels[element] = element
+			}
+		}
+
+		expected := labels[a] != labels[b]
+		require.Equal(t, expected, unionFind.Union(a, b))
+		if expected {
+			old, label := max(labels[a], labels[b]), min(labels[a], labels[b])
+			for element := range labels {
+				if labels[element] == old {
+					labels[element] = label
+				}
+			}
+		}
+
+		c, d := rng.Intn(3000), rng.Intn(3000)
+		if _, ok := labels[c]; ok {
+			if _, ok := labels[d]; ok {
+				require.Equal(t, labels[c] == labels[d], unionFind.Connected(c, d))
+			}
+		}
+	}
+
+	sets, sizes := map[int]bool{}, map[int]int{}
+	for _, label := range labels {
+		sets[label] = true
+		sizes[label]++
+	}
+	require.EqualValues(t, len(labels), unionFind.Count())
+	require.EqualValues(t, len(sets), unionFind.Sets())
+	for element, label := range labels {
+		require.EqualValues(t, sizes[label], unionFind.SetSize(element))
+		require.EqualValues(t, unionFind.Find(label), unionFind.Find(element))
+	}
+}
+
+func TestUnionFindFormat(t *testing.T) {
+	unionFind := createUnionFind()
+	require.Equal(t, "unionfind{}", fmt.Sprint(unionFind))
+	unionFind = createUnionFind("A", "B", "C", "D")
+	unionFind.Union("D", "B")
+	unionFind.Union("A", "D")
+	require.Equal(t, "unionfind{{A B D} {C}}", fmt.Sprint(unionFind))
+	require.Equal(t, `unionfind.UnionFind[string]{{"A", "B", "D"}, {"C"}}`, fmt.Sprintf("%#v", unionFind))
+	require.Equal(t, unionFind.Find("D"), unionFind.Find("B"))
+}
+
+func TestUnionFindJSON(t *testing.T) {
+	unionFind := createUnionFind()
+	data, err := json.Marshal(unionFind)
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	unionFind = createUnionFind("A", "B", "C", "D")
+	unionFind.Union("D", "B")
+	unionFind.Union("A", "D")
+	data, err = json.Marshal(unionFind)
+	require.NoError(t, err)
+	require.Equal(t, `[["A","B","D"],["C"]]`, string(data))
+
+	copied := createUnionFind("E")
+	require.NoError(t, json.Unmarshal(data, copied))
+	require.EqualValues(t, 4, copied.Count())
+	require.EqualValues(t, 2, copied.Sets())
+	require.False(t, copied.Contains("E"))
+	require.True(t, copied.Connected("B", "D"))
+	require.False(t, copied.Connected("A", "C"))
+	require.EqualValues(t, 3, copied.SetSize("A"))
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[["A"],[1]]`), copied))
+	require.Error(t, json.Unmarshal([]byte(`[["A"],[]]`), copied), "An empty set")
+	require.Error(t, json.Unmarshal([]byte(`[["A","B"],["B"]]`), copied), "An element in two sets")
+	require.Error(t, json.Unmarshal([]byte(`[["A","A"]]`), copied), "An element twice in a set")
+	again, err := json.Marshal(copied)
+	require.NoError(t, err)
+	require.Equal(t, string(data), string(again), "A failed decoding changes nothing")
+}
+
+func TestUnionFindBinary(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	unionFind := createUnionFind()
+	for i := 0; i < 3000; i++ {
+		unionFind.Add(fmt.Sprint(i))
+	}
+	for i := 0; i < 2500; i++ {
+		unionFind.Union(fmt.Sprint(rng.Intn(3000)), fmt.Sprint(rng.Intn(3000)))
+	}
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(unionFind))
+	data := buffer.Bytes()
+
+	copied := createUnionFind("A")
+	require.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(copied))
+	require.Equal(t, mustMarshal(t, unionFind), mustMarshal(t, copied))
+	require.Equal(t, unionFind.Sets(), copied.Sets())
+	for i := 0; i < 3000; i += 7 {
+		element := fmt.Sprint(i)
+		require.Equal(t, unionFind.SetSize(element), copied.SetSize(element))
+		require.Equal(t, unionFind.Connected(element, "0"), copied.Connected(element, "0"))
+	}
+
+	require.Error(t, gob.NewDecoder(bytes.NewReader(data[:len(data)-1])).Decode(copied))
+	require.Equal(t, mustMarshal(t, unionFind), mustMarshal(t, copied), "A failed read changes nothing")
+}
+
+func mustMarshal(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,44 @@
+package unionfind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// -------------------- FORMAT PRIMITIVES --------------------
+
+// String formats every set of the structure, like unionfind{{a b} {c}}. The sets, and the elements of each of
+// them, are in the order their elements were added.
+func (forest *disjointSetForest[T]) String() string {
+	sets := forest.group("%v", " ")
+	for i, set := range sets {
+		sets[i] = "{" + set + "}"
+	}
+	return "unionfind{" + strings.Join(sets, " ") + "}"
+}
+
+// GoString formats the sets as Go syntax, like unionfind.UnionFind[string]{{"a", "b"}, {"c"}}.
+func (forest *disjointSetForest[T]) GoString() string {
+	sets := forest.group("%#v", ", ")
+	for i, set := range sets {
+		sets[i] = "{" + set + "}"
+	}
+	return fmt.Sprintf("unionfind.UnionFind[%v]{%s}", reflect.TypeFor[T](), strings.Join(sets, ", "))
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// group formats the elements of every set joined by separator
+func (forest *disjointSetForest[T]) group(verb string, separator string) []string {
+	sets := forest.partition()
+	texts := make([]string, len(sets))
+	for i, set := range sets {
+		elements := make([]string, len(set))
+		for j, element := range set {
+			elements[j] = fmt.Sprintf(verb, element)
+		}
+		texts[i] = strings.Join(elements, separator)
+	}
+	return texts
+}
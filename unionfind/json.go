@@ -0,0 +1,81 @@
+package unionfind
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	errEmptySet  = errors.New("unionfind: a set must have at least one element")
+	errDuplicate = errors.New("unionfind: an element belongs to more than one set")
+)
+
+// -------------------- JSON PRIMITIVES --------------------
+
+// MarshalJSON encodes the structure as a JSON array of its sets, each one an array of its elements, with the sets
+// and their elements in the order they were added.
+func (forest *disjointSetForest[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(forest.partition())
+}
+
+// UnmarshalJSON replaces the sets of the structure with those of a JSON array written by MarshalJSON, keeping its
+// cmp. A JSON null leaves the structure as it is, and an array that cannot be decoded, that holds an empty set or
+// that repeats an element returns an error without changing it.
+func (forest *disjointSetForest[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var sets [][]T
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return err
+	}
+
+	read := CreateUnionFind(forest.cmp).(*disjointSetForest[T])
+	for _, set := range sets {
+		if err := read.addSet(set); err != nil {
+			return err
+		}
+	}
+	*forest = *read
+	return nil
+}
+
+// ----------------------- AUXILIARY FUNCTIONS -----------------------
+
+// addSet adds the elements as one set, failing if it is empty or if an element is repeated. The elements added
+// before the failure are kept, so it is meant for a structure that is thrown away if it fails
+func (forest *disjointSetForest[T]) addSet(set []T) error {
+	if len(set) == 0 {
+		return errEmptySet
+	}
+	for _, element := range set {
+		if forest.Contains(element) {
+			return errDuplicate
+		}
+		forest.Add(element)
+		forest.Union(set[0], element)
+	}
+	return nil
+}
+
+// partition returns the elements of every set, the sets and their elements in the order they were added. It walks
+// the trees without compressing them, so that it does not change the structure.
+func (forest *disjointSetForest[T]) partition() [][]T {
+	positions := make(map[int]int, forest.sets)
+	sets := make([][]T, 0, forest.sets)
+	for i, element := range forest.elements {
+		root := i
+		for forest.parents[root] != root {
+			root = forest.parents[root]
+		}
+		position, found := positions[root]
+		if !found {
+			position = len(sets)
+			positions[root] = position
+			sets = append(sets, nil)
+		}
+		sets[position] = append(sets[position], element)
+	}
+	return sets
+}
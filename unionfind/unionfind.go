@@ -0,0 +1,35 @@
+package unionfind
+
+// UnionFind is a generic interface representing a partition of elements of type T into disjoint sets, where every
+// set is identified by one of its elements, its representative.
+type UnionFind[T any] interface {
+
+	// Add inserts the element as a set of its own. If it already belongs to the structure, nothing changes.
+	Add(T)
+
+	// Contains returns true if the element belongs to the structure, false otherwise.
+	Contains(T) bool
+
+	// Find returns the representative of the set of the element. If the element does not belong to the
+	// structure, it panics with "The element does not belong to the structure".
+	Find(T) T
+
+	// Union merges the sets of both elements, returning true if they were different sets and false if they already
+	// were the same one. If any of them does not belong to the structure, it panics with
+	// "The element does not belong to the structure".
+	Union(a T, b T) bool
+
+	// Connected returns true if both elements are in the same set. If any of them does not belong to the
+	// structure, it panics with "The element does not belong to the structure".
+	Connected(a T, b T) bool
+
+	// SetSize returns the number of elements in the set of the element. If the element does not belong to the
+	// structure, it panics with "The element does not belong to the structure".
+	SetSize(T) int
+
+	// Count returns the number of elements in the structure.
+	Count() int
+
+	// Sets returns the number of disjoint sets in the structure.
+	Sets() int
+}